@@ -1,12 +1,17 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 	"pteroclient-wails/pkg/config"
+	"pteroclient-wails/pkg/consolelog"
 	"pteroclient-wails/pkg/pterodactyl"
 )
 
@@ -14,10 +19,23 @@ import (
 type App struct {
 	ctx          context.Context
 	config       *config.MultiConfigManager
-	client       *pterodactyl.Client       // Client API for file operations
-	adminClient  *pterodactyl.Client       // Admin API for server listing (optional)
-	consoleWS    *pterodactyl.ConsoleWebSocket
-	serverPanelMap map[string]string // Maps server ID to panel name
+	clientMu     sync.RWMutex
+	client       *pterodactyl.Client       // Client API for file operations, read with currentClient()
+	adminClient  *pterodactyl.Client       // Admin API for server listing (optional), read with currentAdminClient()
+	consolesMu   sync.Mutex
+	consoles     map[string]*consoleSession // Open console sessions by server ID
+	activeConsole string                    // Server whose console events are also emitted unscoped
+	recorderMu   sync.Mutex
+	recorder     *consolelog.Recorder // Console log capture, nil when disabled
+	clients      *clientPool // Long-lived client per panel and the panel of each server
+	transfersMu  sync.Mutex
+	transfers    map[string]context.CancelFunc // Running file transfers by transfer ID
+	transferSeq  uint64
+	statsMu      sync.Mutex
+	statsHistory map[string]*statsRing // Rolling stats samples by server ID
+	requestsMu     sync.Mutex
+	requestsCtx    context.Context    // Parent of the contexts of running binding calls
+	requestsCancel context.CancelFunc // Cancels requestsCtx on navigation
 }
 
 // NewApp creates a new App application struct
@@ -25,10 +43,33 @@ func NewApp() *App {
 	return &App{}
 }
 
+// currentClient returns the client of the active panel and server, or nil if not connected
+// Bindings take one snapshot per call so a concurrent switch can't change it midway.
+func (a *App) currentClient() *pterodactyl.Client {
+	a.clientMu.RLock()
+	defer a.clientMu.RUnlock()
+	return a.client
+}
+
+// currentAdminClient returns the admin API client of the active panel, or nil if it has no admin key
+func (a *App) currentAdminClient() *pterodactyl.Client {
+	a.clientMu.RLock()
+	defer a.clientMu.RUnlock()
+	return a.adminClient
+}
+
+// setClients replaces the active clients, which are owned by the client pool
+func (a *App) setClients(client, adminClient *pterodactyl.Client) {
+	a.clientMu.Lock()
+	defer a.clientMu.Unlock()
+	
+	a.client, a.adminClient = client, adminClient
+}
+
 // startup is called when the app starts
 func (a *App) startup(ctx context.Context) {
 	a.ctx = ctx
-	a.serverPanelMap = make(map[string]string)
+	a.clients = newClientPool()
 	
 	// Initialize multi-panel config
 	var err error
@@ -38,6 +79,10 @@ func (a *App) startup(ctx context.Context) {
 		return
 	}
 	
+	if err := a.applyConsoleLogConfig(); err != nil {
+		runtime.LogError(a.ctx, "Failed to start console log capture: "+err.Error())
+	}
+	
 	// Connect if we have an active configured panel
 	if a.config.IsConfigured() {
 		a.Connect()
@@ -58,12 +103,8 @@ func (a *App) Connect() error {
 		return fmt.Errorf("panel URL is empty")
 	}
 	
-	// Ensure URL has protocol
-	panelURL := panel.PanelURL
-	if !strings.HasPrefix(panelURL, "http://") && !strings.HasPrefix(panelURL, "https://") {
-		// Default to https if no protocol specified
-		panelURL = "https://" + panelURL
-	}
+	// Ensure URL has protocol, defaulting to https
+	panelURL := panelBaseURL(panel.PanelURL)
 	
 	// Log for debugging (only if context is set)
 	if a.ctx != nil {
@@ -82,45 +123,27 @@ func (a *App) Connect() error {
 		runtime.LogInfo(a.ctx, fmt.Sprintf("[CONNECT] Server ID: %s", serverID))
 	}
 	
-	// Close existing clients to release connections
-	if a.client != nil {
-		if a.ctx != nil {
-			runtime.LogInfo(a.ctx, "[CONNECT] Closing existing client connection")
-		}
-		a.client.Close()
-		a.client = nil
-	}
-	if a.adminClient != nil {
-		if a.ctx != nil {
-			runtime.LogInfo(a.ctx, "[CONNECT] Closing existing admin client connection")
-		}
-		a.adminClient.Close()
-		a.adminClient = nil
-	}
+	// Use the panel's pooled client API client (for file operations)
+	client := a.clients.panelClient(*panel).ForServer(serverID)
 	
-	// Create new client API client (for file operations)
-	if a.ctx != nil {
-		runtime.LogInfo(a.ctx, "[CONNECT] Creating new client")
-	}
-	a.client = pterodactyl.NewClient(panelURL, panel.APIKey, serverID)
+	// Use the panel's pooled admin API client if an admin key is provided (for listing all servers)
+	adminClient := a.clients.panelAdminClient(*panel)
 	
-	// Create admin API client if admin key is provided (for listing all servers)
-	if panel.AdminKey != "" {
-		a.adminClient = pterodactyl.NewClient(panelURL, panel.AdminKey, "")
-	} else {
-		a.adminClient = nil
-	}
+	a.setClients(client, adminClient)
+	
+	ctx, cancel := a.requestContext()
+	defer cancel()
 	
 	// If no server ID, just test API connection without server-specific call
 	if serverID != "" {
 		// Test connection to specific server
-		_, err := a.client.GetServerState()
+		_, err := client.GetServerStateCtx(ctx)
 		if err != nil {
 			return fmt.Errorf("connection failed: %v", err)
 		}
 	} else {
 		// Just test that we can list servers (API key is valid)
-		_, err := a.client.ListServers()
+		_, err := client.ListServersCtx(ctx)
 		if err != nil {
 			return fmt.Errorf("API connection failed: %v", err)
 		}
@@ -134,31 +157,23 @@ func (a *App) Connect() error {
 
 // RefreshAllServerMappings refreshes server mappings from all configured panels
 func (a *App) RefreshAllServerMappings() {
-	// Clear existing mappings to avoid stale entries
-	a.serverPanelMap = make(map[string]string)
+	ctx, cancel := a.requestContext()
+	defer cancel()
 	
 	for _, panel := range a.config.GetPanels() {
-		// Create temporary clients for each panel
-		panelURL := panel.PanelURL
-		if !strings.HasPrefix(panelURL, "http://") && !strings.HasPrefix(panelURL, "https://") {
-			panelURL = "https://" + panelURL
-		}
-		
 		// Use the panel's primary API key (which auto-detects if it's admin or client)
-		tmpClient := pterodactyl.NewClient(panelURL, panel.APIKey, "")
-		servers, err := tmpClient.ListServers()
+		servers, err := a.clients.panelClient(panel).ListServersCtx(ctx)
 		if err == nil {
 			// Map all servers from this panel
-			for _, s := range servers {
-				a.serverPanelMap[s.ID] = panel.Name
-			}
+			a.clients.setPanelServers(panel.Name, servers)
 		}
 	}
 }
 
 // ListServers lists all available servers
 func (a *App) ListServers() ([]map[string]interface{}, error) {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return nil, fmt.Errorf("not connected")
 	}
 	
@@ -166,12 +181,15 @@ func (a *App) ListServers() ([]map[string]interface{}, error) {
 	var servers []pterodactyl.ServerInfo
 	var err error
 	
-	if a.adminClient != nil {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	if adminClient := a.currentAdminClient(); adminClient != nil {
 		// Use admin API to list all servers
-		servers, err = a.adminClient.ListServers()
+		servers, err = adminClient.ListServersCtx(ctx)
 	} else {
 		// Use client API to list user's servers
-		servers, err = a.client.ListServers()
+		servers, err = client.ListServersCtx(ctx)
 	}
 	
 	if err != nil {
@@ -179,11 +197,45 @@ func (a *App) ListServers() ([]map[string]interface{}, error) {
 	}
 	
 	// Map servers to the current panel
-	currentPanel := a.config.GetActivePanelName()
-	for _, s := range servers {
-		a.serverPanelMap[s.ID] = currentPanel
+	a.clients.setPanelServers(a.config.GetActivePanelName(), servers)
+	
+	return a.serversToMaps(servers), nil
+}
+
+// SearchServers lists the servers of the active panel whose name contains query
+// allServers includes servers of other users when the client API key belongs to
+// a root admin; an admin key always searches every server.
+func (a *App) SearchServers(query string, allServers bool) ([]map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+	
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	if adminClient := a.currentAdminClient(); adminClient != nil {
+		client = adminClient
+	}
+	
+	filter := pterodactyl.ServerFilter{Name: query}
+	if allServers {
+		filter.Type = pterodactyl.ServerTypeAdminAll
 	}
 	
+	servers, err := client.IterateServersCtx(ctx, filter).All()
+	if err != nil {
+		return nil, err
+	}
+	
+	return a.serversToMaps(servers), nil
+}
+
+// serversToMaps converts servers to the map format used by the frontend
+func (a *App) serversToMaps(servers []pterodactyl.ServerInfo) []map[string]interface{} {
+	adminClient := a.currentAdminClient()
+	isAdmin := adminClient != nil && adminClient.IsAdmin()
+	
 	result := make([]map[string]interface{}, len(servers))
 	for i, s := range servers {
 		result[i] = map[string]interface{}{
@@ -192,27 +244,30 @@ func (a *App) ListServers() ([]map[string]interface{}, error) {
 			"description": s.Description,
 			"isOwner":     s.IsOwner,
 			"status":      s.Status,
-			"isAdmin":     a.adminClient != nil && a.adminClient.IsAdmin(),
+			"primaryAllocation": s.PrimaryAllocation,
+			"node":          s.Node,
+			"egg":           s.Egg,
+			"sftp":          sftpToMap(s.SFTP),
+			"limits":        limitsToMap(s.Limits),
+			"featureLimits": featureLimitsToMap(s.FeatureLimits),
+			"isAdmin":     isAdmin,
 		}
 	}
 	
-	return result, nil
+	return result
 }
 
 // SwitchServer switches to a different server
 func (a *App) SwitchServer(serverID string) error {
-	if a.client == nil {
+	if a.currentClient() == nil {
 		return fmt.Errorf("not connected")
 	}
 	
-	// Disconnect console if connected
-	if a.consoleWS != nil && a.consoleWS.IsConnected() {
-		a.consoleWS.Close()
-		runtime.EventsEmit(a.ctx, "console-connected", false)
-	}
+	// Calls made for the previous server are no longer wanted
+	a.CancelRequests()
 	
 	// Check if we're switching to a server on a different panel
-	if panelName, ok := a.serverPanelMap[serverID]; ok {
+	if panelName, ok := a.clients.panelOf(serverID); ok {
 		if panelName != a.config.GetActivePanelName() {
 			// Server is on a different panel, switch to that panel first
 			if err := a.SwitchPanel(panelName); err != nil {
@@ -221,14 +276,23 @@ func (a *App) SwitchServer(serverID string) error {
 		}
 	}
 	
-	// Update client server ID
-	a.client.SetServerID(serverID)
+	// Point the active client at the new server, leaving copies in use by running calls untouched
+	a.clientMu.Lock()
+	a.client = a.client.ForServer(serverID)
+	client := a.client
+	a.clientMu.Unlock()
+	
+	// Console sessions of other servers stay open, only the unscoped events follow the switch
+	a.setActiveConsole(serverID)
 	
 	// Update config for active panel
 	a.config.UpdateActivePanelServer(serverID)
 	
 	// Test connection to new server
-	_, err := a.client.GetServerState()
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	_, err := client.GetServerStateCtx(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to connect to server: %v", err)
 	}
@@ -241,198 +305,143 @@ func (a *App) SwitchServer(serverID string) error {
 
 // ListFilesFromServer lists files from a specific server without switching active server
 func (a *App) ListFilesFromServer(serverID string, path string) ([]map[string]interface{}, error) {
-	if a.client == nil {
-		return nil, fmt.Errorf("not connected")
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	var files []pterodactyl.FileInfo
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		files, err = c.ListFilesCtx(ctx, path)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 	
-	// Check which panel this server belongs to
-	panelName, ok := a.serverPanelMap[serverID]
-	if !ok {
-		// Try to refresh mappings if server not found
-		a.RefreshAllServerMappings()
-		panelName, ok = a.serverPanelMap[serverID]
-		if !ok {
-			return nil, fmt.Errorf("server %s not found in any configured panel", serverID)
+	// Convert to map format
+	result := make([]map[string]interface{}, len(files))
+	for i, f := range files {
+		result[i] = map[string]interface{}{
+			"name":      f.Name,
+			"size":      f.Size,
+			"mode":      f.Mode,
+			"modTime":   f.ModifiedAt,
+			"isDir":     !f.IsFile && !f.IsSymlink,
+			"isFile":    f.IsFile,
+			"isSymlink": f.IsSymlink,
 		}
 	}
-	
-	// If it's the current panel, use the existing client
-	if panelName == a.config.GetActivePanelName() {
-		currentServerID := a.client.GetServerID()
-		a.client.SetServerID(serverID)
-		files, err := a.client.ListFiles(path)
-		a.client.SetServerID(currentServerID)
+	return result, nil
+}
+
+// serverClient returns a client for the given server, which may belong to any configured panel
+func (a *App) serverClient(serverID string) (*pterodactyl.Client, error) {
+	clients, errs := a.serverClients([]string{serverID})
+	return clients[0], errs[0]
+}
+
+// serverClients returns a client or an error for each of the given servers
+// Server mappings are refreshed at most once, however many servers are unknown.
+func (a *App) serverClients(serverIDs []string) ([]*pterodactyl.Client, []error) {
+	clients := make([]*pterodactyl.Client, len(serverIDs))
+	errs := make([]error, len(serverIDs))
+	
+	active := a.currentClient()
+	if active == nil {
+		for i := range errs {
+			errs[i] = fmt.Errorf("not connected")
+		}
+		return clients, errs
+	}
+	
+	var panels []config.PanelConfig
+	refreshed := false
+	for i, serverID := range serverIDs {
+		// Check which panel this server belongs to
+		panelName, ok := a.clients.panelOf(serverID)
+		if !ok && active.GetServerID() == serverID {
+			// The active server may be configured by its short identifier while
+			// client listings map servers by UUID, use the active client as is
+			clients[i] = active
+			continue
+		}
+		if !ok && !refreshed {
+			// Try to refresh mappings if server not found
+			a.RefreshAllServerMappings()
+			refreshed = true
+			panelName, ok = a.clients.panelOf(serverID)
+		}
+		if !ok {
+			errs[i] = fmt.Errorf("server %s not found in any configured panel", serverID)
+			continue
+		}
 		
-		if err != nil {
-			return nil, err
+		if panels == nil {
+			panels = a.config.GetPanels()
 		}
 		
-		// Convert to map format
-		result := make([]map[string]interface{}, len(files))
-		for i, f := range files {
-			result[i] = map[string]interface{}{
-				"name":      f.Name,
-				"size":      f.Size,
-				"mode":      f.Mode,
-				"modTime":   f.ModifiedAt,
-				"isDir":     !f.IsFile && !f.IsSymlink,
-				"isFile":    f.IsFile,
-				"isSymlink": f.IsSymlink,
+		// Panel configuration not found unless a panel matches below
+		errs[i] = fmt.Errorf("panel configuration for server %s not found", serverID)
+		for _, panel := range panels {
+			if panel.Name == panelName {
+				clients[i], errs[i] = a.clients.panelClient(panel).ForServer(serverID), nil
+				break
 			}
 		}
-		return result, nil
 	}
 	
-// Server is from a different panel - find and use that panel's credentials
-	for _, panel := range a.config.GetPanels() {
-		if panel.Name == panelName {
-			// Create a temporary client for this panel
-			// Always use the Client API key for file operations
-			panelURL := panel.PanelURL
-			if !strings.HasPrefix(panelURL, "http://") && !strings.HasPrefix(panelURL, "https://") {
-				panelURL = "https://" + panelURL
-			}
-			
-			tmpClient := pterodactyl.NewClient(panelURL, panel.APIKey, serverID)
-			files, err := tmpClient.ListFiles(path)
-			
-			if err != nil {
-				return nil, err
-			}
-			
-			// Convert to map format
-			result := make([]map[string]interface{}, len(files))
-			for i, f := range files {
-				result[i] = map[string]interface{}{
-					"name":      f.Name,
-					"size":      f.Size,
-					"mode":      f.Mode,
-					"modTime":   f.ModifiedAt,
-					"isDir":     !f.IsFile && !f.IsSymlink,
-					"isFile":    f.IsFile,
-					"isSymlink": f.IsSymlink,
-				}
-			}
-			return result, nil
-		}
+	return clients, errs
+}
+
+// withServerClient runs fn with a client pointed at the given server, which may belong to any configured panel
+func (a *App) withServerClient(serverID string, fn func(*pterodactyl.Client) error) error {
+	client, err := a.serverClient(serverID)
+	if err != nil {
+		return err
 	}
-	
-	// Panel configuration not found
-	return nil, fmt.Errorf("panel configuration for server %s not found", serverID)
+	return fn(client)
 }
 
 // GetFileContentFromServer gets file content from a specific server without switching active server
 func (a *App) GetFileContentFromServer(serverID string, path string) (string, error) {
-	if a.client == nil {
-		return "", fmt.Errorf("not connected")
-	}
-	
 	// Log the request for debugging
 	if a.ctx != nil {
 		runtime.LogDebugf(a.ctx, "GetFileContentFromServer called for server %s, path %s", serverID, path)
 	}
 	
-	// Check which panel this server belongs to
-	panelName, ok := a.serverPanelMap[serverID]
-	if !ok {
-		// Try to refresh mappings if server not found
-		a.RefreshAllServerMappings()
-		panelName, ok = a.serverPanelMap[serverID]
-		if !ok {
-			return "", fmt.Errorf("server %s not found in any configured panel", serverID)
-		}
+	client, err := a.serverClient(serverID)
+	if err != nil {
+		return "", err
 	}
 	
-	// Log panel info
-	if a.ctx != nil {
-		runtime.LogDebugf(a.ctx, "Server %s belongs to panel %s", serverID, panelName)
-	}
+	ctx, cancel := a.requestContext()
+	defer cancel()
 	
-	// Helper function to handle errors
-	handleError := func(err error) error {
-		if err == nil {
-			return nil
-		}
+	content, err := client.GetFileContentCtx(ctx, path)
+	if err != nil {
 		// Check for common errors and provide better messages
-		if strings.Contains(err.Error(), "status 500") {
-			return fmt.Errorf("daemon connection error: the server daemon may be offline or experiencing issues")
-		} else if strings.Contains(err.Error(), "status 404") {
-			return fmt.Errorf("file not found: %s (server: %s)", path, serverID)
-		} else if strings.Contains(err.Error(), "status 403") {
-			return fmt.Errorf("permission denied: cannot access this file")
-		}
-		return err
-	}
-	
-	// If it's the current panel, use the existing client
-	if panelName == a.config.GetActivePanelName() {
-		currentServerID := a.client.GetServerID()
-		a.client.SetServerID(serverID)
-		content, err := a.client.GetFileContent(path)
-		a.client.SetServerID(currentServerID)
-		return content, handleError(err)
-	}
-	
-	// Server is from a different panel - find and use that panel's credentials
-	for _, panel := range a.config.GetPanels() {
-		if panel.Name == panelName {
-			// Create a temporary client for this panel
-			panelURL := panel.PanelURL
-			if !strings.HasPrefix(panelURL, "http://") && !strings.HasPrefix(panelURL, "https://") {
-				panelURL = "https://" + panelURL
-			}
-			
-			if a.ctx != nil {
-				runtime.LogDebugf(a.ctx, "Creating temp client for panel %s with URL %s, serverID %s", panelName, panelURL, serverID)
-			}
-			
-			tmpClient := pterodactyl.NewClient(panelURL, panel.APIKey, serverID)
-			content, err := tmpClient.GetFileContent(path)
-			return content, handleError(err)
+		switch {
+		case pterodactyl.IsDaemonUnavailable(err):
+			return "", fmt.Errorf("daemon connection error: the server daemon may be offline or experiencing issues")
+		case pterodactyl.IsNotFound(err):
+			return "", fmt.Errorf("file not found: %s (server: %s)", path, serverID)
+		case pterodactyl.IsForbidden(err):
+			return "", fmt.Errorf("permission denied: cannot access this file")
 		}
+		return "", err
 	}
 	
-	// Panel configuration not found
-	return "", fmt.Errorf("panel configuration for server %s not found", serverID)
+	return content, nil
 }
 
 // SaveFileContentToServer saves file content to a specific server without switching active server
 func (a *App) SaveFileContentToServer(serverID string, path string, content string) error {
-	if a.client == nil {
-		return fmt.Errorf("not connected")
-	}
+	ctx, cancel := a.requestContext()
+	defer cancel()
 	
-	// Check which panel this server belongs to
-	panelName, ok := a.serverPanelMap[serverID]
-	if !ok {
-		return fmt.Errorf("server %s not found in server map", serverID)
-	}
-	
-	// If it's the current panel, use the existing client
-	if panelName == a.config.GetActivePanelName() {
-		currentServerID := a.client.GetServerID()
-		a.client.SetServerID(serverID)
-		err := a.client.SaveFileContent(path, content)
-		a.client.SetServerID(currentServerID)
-		return err
-	}
-	
-	// Server is from a different panel - find and use that panel's credentials
-	for _, panel := range a.config.GetPanels() {
-		if panel.Name == panelName {
-			// Create a temporary client for this panel
-			panelURL := panel.PanelURL
-			if !strings.HasPrefix(panelURL, "http://") && !strings.HasPrefix(panelURL, "https://") {
-				panelURL = "https://" + panelURL
-			}
-			
-			tmpClient := pterodactyl.NewClient(panelURL, panel.APIKey, serverID)
-			return tmpClient.SaveFileContent(path, content)
-		}
-	}
-	
-	// Panel configuration not found
-	return fmt.Errorf("panel configuration for server %s not found", serverID)
+	return a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		return c.SaveFileContentCtx(ctx, path, content)
+	})
 }
 
 // Panel Management Methods
@@ -462,6 +471,7 @@ func (a *App) GetPanels() []map[string]interface{} {
 			"name":     p.Name,
 			"panelURL": p.PanelURL,
 			"serverID": p.ServerID,
+			"requestBudget": p.RequestBudget,
 		}
 	}
 	
@@ -479,14 +489,6 @@ func (a *App) SwitchPanel(panelName string) error {
 		runtime.LogInfo(a.ctx, fmt.Sprintf("[SWITCH_PANEL] Switching from %s to %s", a.config.GetActivePanelName(), panelName))
 	}
 	
-	// Disconnect console if connected
-	if a.consoleWS != nil && a.consoleWS.IsConnected() {
-		a.consoleWS.Close()
-		if a.ctx != nil {
-			runtime.EventsEmit(a.ctx, "console-connected", false)
-		}
-	}
-	
 	// Set the active panel
 	if err := a.config.SetActivePanel(panelName); err != nil {
 		if a.ctx != nil {
@@ -495,6 +497,9 @@ func (a *App) SwitchPanel(panelName string) error {
 		return err
 	}
 	
+	// Abort calls still running against the previous panel
+	a.CancelRequests()
+	
 	if a.ctx != nil {
 		runtime.LogInfo(a.ctx, "[SWITCH_PANEL] Active panel set, reconnecting...")
 	}
@@ -514,8 +519,11 @@ func (a *App) SwitchPanel(panelName string) error {
 	// Refresh server mappings for all panels
 	a.RefreshAllServerMappings()
 	
+	client := a.currentClient()
+	a.setActiveConsole(client.GetServerID())
+	
 	if a.ctx != nil {
-		runtime.LogInfo(a.ctx, fmt.Sprintf("[SWITCH_PANEL] Switch complete. Client state - URL: %s, ServerID: %s", a.client.GetBaseURL(), a.client.GetServerID()))
+		runtime.LogInfo(a.ctx, fmt.Sprintf("[SWITCH_PANEL] Switch complete. Client state - URL: %s, ServerID: %s", client.GetBaseURL(), client.GetServerID()))
 	}
 	
 	// Emit panel changed event
@@ -538,9 +546,49 @@ func (a *App) AddPanel(name, panelURL, apiKey string) error {
 		APIKey:   apiKey,
 	}
 	
+	// Keep the request budget when updating an existing panel
+	for _, p := range a.config.GetPanels() {
+		if p.Name == name {
+			panel.RequestBudget = p.RequestBudget
+		}
+	}
+	
 	return a.config.AddOrUpdatePanel(panel)
 }
 
+// SetPanelRequestBudget limits the API requests sent to a panel per minute, 0 for no limit
+func (a *App) SetPanelRequestBudget(panelName string, perMinute int) error {
+	if perMinute < 0 {
+		return fmt.Errorf("request budget must not be negative")
+	}
+	
+	for _, p := range a.config.GetPanels() {
+		if p.Name == panelName {
+			p.RequestBudget = perMinute
+			if err := a.config.AddOrUpdatePanel(p); err != nil {
+				return err
+			}
+			
+			// Apply to the running clients of the panel
+			a.clients.setRequestBudget(panelName, perMinute)
+			return nil
+		}
+	}
+	
+	return fmt.Errorf("panel not found: %s", panelName)
+}
+
+// GetPanelRateLimit returns the request quota last reported by a panel and its request budget
+func (a *App) GetPanelRateLimit(panelName string) (pterodactyl.RateLimit, error) {
+	for _, p := range a.config.GetPanels() {
+		if p.Name == panelName {
+			return a.clients.panelClient(p).RateLimit(), nil
+		}
+	}
+	
+	return pterodactyl.RateLimit{}, fmt.Errorf("panel not found: %s", panelName)
+}
+
 // RemovePanel removes a panel configuration
 func (a *App) RemovePanel(name string) error {
 	// Can't remove the active panel if it's the only one
@@ -559,6 +607,10 @@ func (a *App) RemovePanel(name string) error {
 		}
 	}
 	
+	// Console sessions can't refresh their credentials once the panel is gone
+	a.closePanelConsoles(name)
+	a.clients.removePanel(name)
+	
 	return a.config.RemovePanel(name)
 }
 
@@ -591,11 +643,15 @@ func (a *App) SaveConfig(panelURL, apiKey, serverID string) error {
 
 // GetServerState returns server state
 func (a *App) GetServerState() (string, error) {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return "disconnected", nil
 	}
 	
-	state, err := a.client.GetServerState()
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	state, err := client.GetServerStateCtx(ctx)
 	if err != nil {
 		return "error", err
 	}
@@ -605,88 +661,65 @@ func (a *App) GetServerState() (string, error) {
 
 // SetPowerState sets server power state
 func (a *App) SetPowerState(signal string) error {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("not connected")
 	}
 	
-	return a.client.SetPowerState(signal)
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return client.SetPowerStateCtx(ctx, signal)
 }
 
-// SendCommand sends a console command
+// SendCommand sends a console command to the active server
 func (a *App) SendCommand(command string) error {
-	if a.consoleWS == nil || !a.consoleWS.IsConnected() {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("console not connected")
 	}
 	
-	return a.consoleWS.SendCommand(command)
+	return a.SendConsoleCommand(client.GetServerID(), command)
 }
 
-// ConnectConsole connects to console WebSocket
+// ConnectConsole connects to the console of the active server
 func (a *App) ConnectConsole() error {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("not connected to server")
 	}
 	
-	// Get WebSocket credentials
-	creds, err := a.client.GetWebSocketCredentials()
-	if err != nil {
-		return fmt.Errorf("failed to get WebSocket credentials: %v", err)
-	}
-	
-// Create WebSocket with origin
-	cfg := a.config.GetConfig()
-	panelOrigin := strings.TrimSuffix(cfg.PanelURL, "/")
-	
-	a.consoleWS = pterodactyl.NewConsoleWebSocketWithOrigin(
-		creds.Socket, creds.Token, cfg.ServerID, panelOrigin,
-	)
-	
-	// Set up message handler
-	a.consoleWS.OnOutput = func(message string) {
-		// Send raw ANSI text; frontend will render colors
-		runtime.EventsEmit(a.ctx, "console-output", message)
-	}
-	
-	a.consoleWS.OnError = func(err error) {
-		runtime.EventsEmit(a.ctx, "console-error", err.Error())
-	}
-	
-	// Connect
-	err = a.consoleWS.Connect()
-	if err != nil {
-		return fmt.Errorf("failed to connect: %v", err)
-	}
-	
-	// Request initial logs
-	if err := a.consoleWS.RequestLogs(); err != nil {
-		runtime.EventsEmit(a.ctx, "console-error", "failed to request logs: "+err.Error())
-	}
-	
-	runtime.EventsEmit(a.ctx, "console-connected", true)
-	return nil
+	serverID := client.GetServerID()
+	a.setActiveConsole(serverID)
+	return a.OpenConsole(serverID)
 }
 
-// DisconnectConsole disconnects console
+// DisconnectConsole disconnects the console of the active server
 func (a *App) DisconnectConsole() error {
-	if a.consoleWS != nil {
-		return a.consoleWS.Close()
+	client := a.currentClient()
+	if client == nil {
+		return nil
 	}
-	return nil
+	return a.CloseConsole(client.GetServerID())
 }
 
 // ListFiles lists files in a directory
 func (a *App) ListFiles(path string) ([]map[string]interface{}, error) {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return nil, fmt.Errorf("not connected")
 	}
 	
 	if a.ctx != nil {
 		runtime.LogInfo(a.ctx, fmt.Sprintf("[LIST_FILES] Called with path: %s", path))
-		runtime.LogInfo(a.ctx, fmt.Sprintf("[LIST_FILES] Current client state - URL: %s, ServerID: %s", a.client.GetBaseURL(), a.client.GetServerID()))
+		runtime.LogInfo(a.ctx, fmt.Sprintf("[LIST_FILES] Current client state - URL: %s, ServerID: %s", client.GetBaseURL(), client.GetServerID()))
 		runtime.LogInfo(a.ctx, fmt.Sprintf("[LIST_FILES] Active panel: %s", a.config.GetActivePanelName()))
 	}
 	
-	files, err := a.client.ListFiles(path)
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	files, err := client.ListFilesCtx(ctx, path)
 	if err != nil {
 		if a.ctx != nil {
 			runtime.LogError(a.ctx, fmt.Sprintf("[LIST_FILES] Error: %v", err))
@@ -712,17 +745,21 @@ func (a *App) ListFiles(path string) ([]map[string]interface{}, error) {
 
 // GetFileContent gets file content
 func (a *App) GetFileContent(path string) (string, error) {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return "", fmt.Errorf("not connected")
 	}
 	
 	if a.ctx != nil {
 		runtime.LogInfo(a.ctx, fmt.Sprintf("[GET_FILE] Called with path: %s", path))
-		runtime.LogInfo(a.ctx, fmt.Sprintf("[GET_FILE] Current client state - URL: %s, ServerID: %s", a.client.GetBaseURL(), a.client.GetServerID()))
+		runtime.LogInfo(a.ctx, fmt.Sprintf("[GET_FILE] Current client state - URL: %s, ServerID: %s", client.GetBaseURL(), client.GetServerID()))
 		runtime.LogInfo(a.ctx, fmt.Sprintf("[GET_FILE] Active panel: %s", a.config.GetActivePanelName()))
 	}
 	
-	content, err := a.client.GetFileContent(path)
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	content, err := client.GetFileContentCtx(ctx, path)
 	if err != nil {
 		if a.ctx != nil {
 			runtime.LogError(a.ctx, fmt.Sprintf("[GET_FILE] Error: %v", err))
@@ -739,57 +776,53 @@ func (a *App) GetFileContent(path string) (string, error) {
 
 // SaveFileContent saves file content
 func (a *App) SaveFileContent(path, content string) error {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("not connected")
 	}
 	
-	return a.client.SaveFileContent(path, content)
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return client.SaveFileContentCtx(ctx, path, content)
 }
 
 // CreateFolder creates a new folder
 func (a *App) CreateFolder(path string) error {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("not connected")
 	}
 	
 	// Split path into directory and name
-	var dir, name string
-	lastSlash := strings.LastIndex(path, "/")
-	if lastSlash == -1 || lastSlash == 0 {
-		dir = "/"
-		name = strings.TrimPrefix(path, "/")
-	} else {
-		dir = path[:lastSlash]
-		name = path[lastSlash+1:]
-	}
+	dir, name := splitPath(path)
 	
-	return a.client.CreateDirectory(dir, name)
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return client.CreateDirectoryCtx(ctx, dir, name)
 }
 
 // DeleteFiles deletes files or folders
 func (a *App) DeleteFiles(paths []string) error {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("not connected")
 	}
 	
 	// Group files by directory
 	filesByDir := make(map[string][]string)
 	for _, path := range paths {
-		var dir, name string
-		lastSlash := strings.LastIndex(path, "/")
-		if lastSlash == -1 || lastSlash == 0 {
-			dir = "/"
-			name = strings.TrimPrefix(path, "/")
-		} else {
-			dir = path[:lastSlash]
-			name = path[lastSlash+1:]
-		}
+		dir, name := splitPath(path)
 		filesByDir[dir] = append(filesByDir[dir], name)
 	}
 	
-	// Delete files in each directory
+	// Delete files in each directory, navigating away must not stop halfway
+	ctx, cancel := a.batchContext()
+	defer cancel()
+	
 	for dir, files := range filesByDir {
-		if err := a.client.DeleteFiles(dir, files); err != nil {
+		if err := client.DeleteFilesCtx(ctx, dir, files); err != nil {
 			return err
 		}
 	}
@@ -799,71 +832,253 @@ func (a *App) DeleteFiles(paths []string) error {
 
 // RenameFile renames a file or folder
 func (a *App) RenameFile(oldPath, newPath string) error {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("not connected")
 	}
 	
 	// Split paths to get directory and names
-	var dir, oldName, newName string
+	dir, oldName := splitPath(oldPath)
+	_, newName := splitPath(newPath)
 	
-	// Get directory from old path
-	lastSlash := strings.LastIndex(oldPath, "/")
-	if lastSlash == -1 || lastSlash == 0 {
-		dir = "/"
-		oldName = strings.TrimPrefix(oldPath, "/")
-	} else {
-		dir = oldPath[:lastSlash]
-		oldName = oldPath[lastSlash+1:]
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return client.RenameFileCtx(ctx, dir, oldName, newName)
+}
+
+// CompressFiles compresses files or folders into an archive and returns the archive path
+// All paths must be in the same directory
+func (a *App) CompressFiles(paths []string) (string, error) {
+	client := a.currentClient()
+	if client == nil {
+		return "", fmt.Errorf("not connected")
 	}
 	
-	// Get new name
-	lastSlash = strings.LastIndex(newPath, "/")
-	if lastSlash == -1 || lastSlash == 0 {
-		newName = strings.TrimPrefix(newPath, "/")
-	} else {
-		newName = newPath[lastSlash+1:]
+	if len(paths) == 0 {
+		return "", fmt.Errorf("no files selected")
+	}
+	
+	root, _ := splitPath(paths[0])
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		dir, name := splitPath(path)
+		if dir != root {
+			return "", fmt.Errorf("all files must be in the same directory")
+		}
+		names[i] = name
+	}
+	
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	archive, err := client.CompressFilesCtx(ctx, root, names)
+	if err != nil {
+		return "", err
 	}
 	
-	return a.client.RenameFile(dir, oldName, newName)
+	return strings.TrimSuffix(root, "/") + "/" + archive.Name, nil
+}
+
+// DecompressFile extracts an archive into the directory it is in
+func (a *App) DecompressFile(path string) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+	
+	dir, name := splitPath(path)
+	
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return client.DecompressFileCtx(ctx, dir, name)
+}
+
+// CopyFile duplicates a file next to the original
+func (a *App) CopyFile(path string) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+	
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return client.CopyFileCtx(ctx, path)
+}
+
+// ChmodFiles changes the permissions of files or folders to an octal mode such as "644"
+func (a *App) ChmodFiles(paths []string, mode string) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+	
+	if _, err := strconv.ParseUint(mode, 8, 32); err != nil || len(mode) < 3 || len(mode) > 4 {
+		return fmt.Errorf("invalid mode %q: expected an octal mode like 644", mode)
+	}
+	
+	// Group files by directory
+	filesByDir := make(map[string][]pterodactyl.ChmodFile)
+	for _, path := range paths {
+		dir, name := splitPath(path)
+		filesByDir[dir] = append(filesByDir[dir], pterodactyl.ChmodFile{File: name, Mode: mode})
+	}
+	
+	// Navigating away must not leave only some directories changed
+	ctx, cancel := a.batchContext()
+	defer cancel()
+	
+	for dir, files := range filesByDir {
+		if err := client.ChmodFilesCtx(ctx, dir, files); err != nil {
+			return err
+		}
+	}
+	
+	return nil
+}
+
+// PullRemoteFile makes any server download a URL straight into dir
+// The filename is taken from the response headers when available
+func (a *App) PullRemoteFile(serverID, remoteURL, dir string) error {
+	parsed, err := url.Parse(remoteURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid URL: %s", remoteURL)
+	}
+	
+	if dir == "" {
+		dir = "/"
+	}
+	
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		return c.PullRemoteFileCtx(ctx, pterodactyl.PullRequest{
+			URL:       remoteURL,
+			Directory: dir,
+			UseHeader: true,
+		})
+	})
 }
 
 // UploadFile handles file upload
 func (a *App) UploadFile(path string, content []byte) error {
-	if a.client == nil {
+	client := a.currentClient()
+	if client == nil {
 		return fmt.Errorf("not connected")
 	}
 	
 	// Split path into directory and filename
-	var dir, filename string
+	dir, filename := splitPath(path)
+	
+	// Wrap the bytes without copying them
+	reader := bytes.NewReader(content)
+	
+	ctx, cancel := a.requestContext()
+	defer cancel()
+	
+	return client.UploadFileCtx(ctx, dir, filename, reader)
+}
+
+// splitPath splits a server path into its parent directory and base name
+func splitPath(path string) (dir, name string) {
 	lastSlash := strings.LastIndex(path, "/")
 	if lastSlash == -1 || lastSlash == 0 {
-		dir = "/"
-		filename = strings.TrimPrefix(path, "/")
-	} else {
-		dir = path[:lastSlash]
-		filename = path[lastSlash+1:]
+		return "/", strings.TrimPrefix(path, "/")
 	}
-	
-	// Convert byte array to reader
-	reader := strings.NewReader(string(content))
-	
-	return a.client.UploadFile(dir, filename, reader)
+	return path[:lastSlash], path[lastSlash+1:]
 }
 
-// cleanANSI removes ANSI escape codes
+// cleanANSI removes ANSI escape sequences and control characters, keeping
+// newlines and tabs. A lone carriage return becomes a newline. CSI sequences (colors, cursor movement), OSC/DCS strings
+// and two-byte escapes are all removed, including unterminated ones at the end.
 func cleanANSI(text string) string {
-	// Remove ANSI codes
-	for strings.Contains(text, "\x1b[") {
-		start := strings.Index(text, "\x1b[")
-		if start >= 0 {
-			end := strings.IndexByte(text[start:], 'm')
-			if end > 0 {
-				text = text[:start] + text[start+end+1:]
-			} else {
-				break
+	var sb strings.Builder
+	sb.Grow(len(text))
+	
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == 0x1b:
+			i = skipEscape(text, i+1)
+		case c == 0xc2 && i+1 < len(text) && text[i+1] == 0x9b:
+			// 8-bit CSI, UTF-8 encoded
+			i = skipCSI(text, i+2)
+		case c == '\n' || c == '\t':
+			sb.WriteByte(c)
+			i++
+		case c == '\r':
+			// A lone carriage return redraws the line, keep the redraws apart
+			if i+1 < len(text) && text[i+1] != '\n' {
+				sb.WriteByte('\n')
 			}
+			i++
+		case c < 0x20 || c == 0x7f:
+			// Carriage returns, bells, backspaces and other control characters
+			i++
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	
+	return sb.String()
+}
+
+// skipEscape returns the index after the escape sequence whose ESC precedes text[i]
+func skipEscape(text string, i int) int {
+	if i >= len(text) {
+		return i
+	}
+	
+	switch text[i] {
+	case '[':
+		return skipCSI(text, i+1)
+	case ']', 'P', 'X', '^', '_':
+		// OSC, DCS, SOS, PM and APC strings
+		return skipString(text, i+1)
+	}
+	
+	// Other escapes: optional intermediate bytes followed by a final byte
+	for i < len(text) && text[i] >= 0x20 && text[i] <= 0x2f {
+		i++
+	}
+	if i < len(text) && text[i] >= 0x30 && text[i] <= 0x7e {
+		i++
+	}
+	return i
+}
+
+// skipCSI returns the index after the parameter, intermediate and final bytes
+// of a CSI sequence starting at text[i]. A malformed sequence ends before the
+// offending byte.
+func skipCSI(text string, i int) int {
+	for ; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c >= 0x20 && c <= 0x3f:
+			// Parameter and intermediate bytes
+		case c >= 0x40 && c <= 0x7e:
+			return i + 1
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// skipString returns the index after a control string starting at text[i],
+// terminated by BEL or ST (ESC \)
+func skipString(text string, i int) int {
+	for ; i < len(text); i++ {
+		if text[i] == 0x07 {
+			return i + 1
+		}
+		if text[i] == 0x1b && i+1 < len(text) && text[i+1] == '\\' {
+			return i + 2
 		}
 	}
-	text = strings.ReplaceAll(text, "[m", "")
-	return text
+	return i
 }
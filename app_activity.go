@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+const (
+	// activityPageSize is the largest page the panel serves for activity logs
+	activityPageSize = 100
+	// defaultActivityLimit caps the number of events returned when no limit is given
+	defaultActivityLimit = 200
+)
+
+// ListActivity returns activity events of any server, newest first
+// event filters by event name (partial match, e.g. "server:file.delete"),
+// actor by username or email and from/to by RFC3339 timestamps. Empty
+// filters are ignored and a limit of 0 returns up to 200 events.
+func (a *App) ListActivity(serverID, event, actor, from, to string, limit int) ([]pterodactyl.ActivityEvent, error) {
+	var fromTime, toTime time.Time
+	var err error
+	if from != "" {
+		if fromTime, err = time.Parse(time.RFC3339, from); err != nil {
+			return nil, fmt.Errorf("invalid start time: %v", err)
+		}
+	}
+	if to != "" {
+		if toTime, err = time.Parse(time.RFC3339, to); err != nil {
+			return nil, fmt.Errorf("invalid end time: %v", err)
+		}
+	}
+	if limit <= 0 {
+		limit = defaultActivityLimit
+	}
+
+	actor = strings.ToLower(actor)
+	events := []pterodactyl.ActivityEvent{}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	err = a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		for page := 1; ; page++ {
+			result, err := c.ListActivityCtx(ctx, page, activityPageSize, event)
+			if err != nil {
+				return err
+			}
+
+			for _, e := range result.Events {
+				// Events are sorted newest first, nothing older can match
+				if !fromTime.IsZero() && e.Timestamp.Before(fromTime) {
+					return nil
+				}
+				if !toTime.IsZero() && e.Timestamp.After(toTime) {
+					continue
+				}
+				if actor != "" && !activityActorMatches(e.Actor, actor) {
+					continue
+				}
+
+				events = append(events, e)
+				if len(events) >= limit {
+					return nil
+				}
+			}
+
+			if page >= result.Pagination.TotalPages {
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// activityActorMatches reports whether an actor's username or email contains the lowercase query
+func activityActorMatches(actor *pterodactyl.ActivityActor, query string) bool {
+	if actor == nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(actor.Username), query) ||
+		strings.Contains(strings.ToLower(actor.Email), query)
+}
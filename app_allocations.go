@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// allocationToMap converts an allocation to the map format used by the frontend
+func allocationToMap(al pterodactyl.Allocation) map[string]interface{} {
+	return map[string]interface{}{
+		"id":        al.ID,
+		"ip":        al.IP,
+		"ipAlias":   al.IPAlias,
+		"port":      al.Port,
+		"notes":     al.Notes,
+		"isDefault": al.IsDefault,
+		"address":   al.Address(),
+	}
+}
+
+// ListAllocations lists network allocations of the active server
+func (a *App) ListAllocations() ([]map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	allocations, err := client.ListAllocationsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(allocations))
+	for i, al := range allocations {
+		result[i] = allocationToMap(al)
+	}
+
+	return result, nil
+}
+
+// AssignAllocation assigns a new allocation to the active server
+func (a *App) AssignAllocation() (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	allocation, err := client.AssignAllocationCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return allocationToMap(*allocation), nil
+}
+
+// SetPrimaryAllocation makes an allocation the primary one of the active server
+func (a *App) SetPrimaryAllocation(allocationID int) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	allocation, err := client.SetPrimaryAllocationCtx(ctx, allocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return allocationToMap(*allocation), nil
+}
+
+// SetAllocationNotes updates the notes of an allocation of the active server
+func (a *App) SetAllocationNotes(allocationID int, notes string) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	allocation, err := client.SetAllocationNotesCtx(ctx, allocationID, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	return allocationToMap(*allocation), nil
+}
+
+// UnassignAllocation removes an allocation from the active server
+func (a *App) UnassignAllocation(allocationID int) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.UnassignAllocationCtx(ctx, allocationID)
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// backupToMap converts a backup to the map format used by the frontend
+func backupToMap(b pterodactyl.Backup) map[string]interface{} {
+	return map[string]interface{}{
+		"uuid":         b.UUID,
+		"name":         b.Name,
+		"ignoredFiles": b.IgnoredFiles,
+		"checksum":     b.Checksum,
+		"bytes":        b.Bytes,
+		"isSuccessful": b.IsSuccessful,
+		"isLocked":     b.IsLocked,
+		"createdAt":    b.CreatedAt,
+		"completedAt":  b.CompletedAt,
+	}
+}
+
+// ListBackups lists backups of the active server
+func (a *App) ListBackups() ([]map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	backups, err := client.ListBackupsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(backups))
+	for i, b := range backups {
+		result[i] = backupToMap(b)
+	}
+
+	return result, nil
+}
+
+// CreateBackup starts a backup of the active server
+// Completion is reported through the "backup-completed" event while the console is connected
+func (a *App) CreateBackup(name string, ignored []string, locked bool) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	backup, err := client.CreateBackupCtx(ctx, name, ignored, locked)
+	if err != nil {
+		return nil, err
+	}
+
+	return backupToMap(*backup), nil
+}
+
+// DeleteBackup deletes a backup of the active server
+func (a *App) DeleteBackup(backupUUID string) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.DeleteBackupCtx(ctx, backupUUID)
+}
+
+// RestoreBackup restores a backup of the active server
+func (a *App) RestoreBackup(backupUUID string, truncate bool) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.RestoreBackupCtx(ctx, backupUUID, truncate)
+}
+
+// ToggleBackupLock locks or unlocks a backup of the active server
+func (a *App) ToggleBackupLock(backupUUID string) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	backup, err := client.ToggleBackupLockCtx(ctx, backupUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return backupToMap(*backup), nil
+}
+
+// GetBackupDownloadURL returns a signed download URL for a backup of the active server
+func (a *App) GetBackupDownloadURL(backupUUID string) (string, error) {
+	client := a.currentClient()
+	if client == nil {
+		return "", fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.GetBackupDownloadURLCtx(ctx, backupUUID)
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// consoleSession is an open console connection to a server of any panel
+type consoleSession struct {
+	serverID string
+	panel    string
+	ws       *pterodactyl.ConsoleWebSocket
+	history  *consoleHistory // Recent output for searching
+}
+
+// OpenConsole opens a console session for any server. Each session emits its
+// events on its own channel, e.g. "console-output:<serverID>", and stays
+// connected when the active server or panel changes. Opening a server that
+// already has a session does nothing.
+func (a *App) OpenConsole(serverID string) error {
+	if a.getConsole(serverID) != nil {
+		return nil
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	// Fetch credentials through the server's own panel
+	client, err := a.serverClient(serverID)
+	if err != nil {
+		return fmt.Errorf("failed to get WebSocket credentials: %v", err)
+	}
+	creds, err := client.GetWebSocketCredentialsCtx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get WebSocket credentials: %v", err)
+	}
+
+	// Servers missing from the panel map are served by the active client
+	panelName, mapped := a.clients.panelOf(serverID)
+	if !mapped {
+		panelName = a.config.GetActivePanelName()
+	}
+	session := &consoleSession{
+		serverID: serverID,
+		panel:    panelName,
+		ws:       pterodactyl.NewConsoleWebSocketWithOrigin(creds.Socket, creds.Token, serverID, a.panelOrigin(panelName)),
+		history:  newConsoleHistory(consoleHistorySize),
+	}
+
+	session.ws.OnOutput = func(message string) {
+		session.history.add(message)
+		a.recordConsole(serverID, message)
+		// Send raw ANSI text; frontend will render colors
+		a.emitConsole(serverID, "console-output", message)
+	}
+
+	session.ws.OnError = func(err error) {
+		a.emitConsole(serverID, "console-error", err.Error())
+	}
+
+	session.ws.OnBackupCompleted = func(backup pterodactyl.BackupCompletedEvent) {
+		a.emitConsole(serverID, "backup-completed", backup)
+	}
+
+	session.ws.OnStats = func(stats pterodactyl.Stats) {
+		a.recordStats(serverID, stats)
+		runtime.EventsEmit(a.ctx, "server-stats", map[string]interface{}{
+			"serverId": serverID,
+			"stats":    stats,
+		})
+	}
+
+	// Fetch new credentials for this server when the token expires or the connection drops
+	session.ws.Refresh = func() (*pterodactyl.WebSocketCredentials, error) {
+		if !mapped {
+			// Not in the panel map, keep using the client the session was opened with
+			return client.GetWebSocketCredentials()
+		}
+		var creds *pterodactyl.WebSocketCredentials
+		err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+			var err error
+			creds, err = c.GetWebSocketCredentials()
+			return err
+		})
+		return creds, err
+	}
+
+	session.ws.OnReconnecting = func(attempt int, delay time.Duration, cause error) {
+		a.emitConsole(serverID, "console-reconnecting", map[string]interface{}{
+			"attempt": attempt,
+			"delayMs": delay.Milliseconds(),
+			"error":   cause.Error(),
+		})
+	}
+
+	session.ws.OnReconnected = func() {
+		a.emitConsole(serverID, "console-reconnected", true)
+	}
+
+	// Reconnecting failed for good, forget the session so it can be opened again
+	session.ws.OnGaveUp = func(error) {
+		a.consolesMu.Lock()
+		if a.consoles[serverID] == session {
+			delete(a.consoles, serverID)
+		}
+		a.consolesMu.Unlock()
+		a.emitConsole(serverID, "console-connected", false)
+	}
+
+	if err := session.ws.Connect(); err != nil {
+		return fmt.Errorf("failed to connect: %v", err)
+	}
+
+	a.consolesMu.Lock()
+	if _, ok := a.consoles[serverID]; ok {
+		// Opened concurrently, keep the existing session
+		a.consolesMu.Unlock()
+		session.ws.Close()
+		return nil
+	}
+	if a.consoles == nil {
+		a.consoles = make(map[string]*consoleSession)
+	}
+	a.consoles[serverID] = session
+	a.consolesMu.Unlock()
+
+	// Request initial logs
+	if err := session.ws.RequestLogs(); err != nil {
+		a.emitConsole(serverID, "console-error", "failed to request logs: "+err.Error())
+	}
+
+	a.emitConsole(serverID, "console-connected", true)
+	return nil
+}
+
+// CloseConsole closes the console session of a server
+func (a *App) CloseConsole(serverID string) error {
+	a.consolesMu.Lock()
+	session, ok := a.consoles[serverID]
+	delete(a.consoles, serverID)
+	a.consolesMu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	err := session.ws.Close()
+	a.emitConsole(serverID, "console-connected", false)
+	return err
+}
+
+// ListConsoles lists the open console sessions
+func (a *App) ListConsoles() []map[string]interface{} {
+	a.consolesMu.Lock()
+	defer a.consolesMu.Unlock()
+
+	result := make([]map[string]interface{}, 0, len(a.consoles))
+	for _, session := range a.consoles {
+		result = append(result, map[string]interface{}{
+			"serverId":  session.serverID,
+			"panel":     session.panel,
+			"connected": session.ws.IsConnected(),
+			"active":    session.serverID == a.activeConsole,
+		})
+	}
+
+	return result
+}
+
+// SendConsoleCommand sends a command to the console of any server with an open session
+func (a *App) SendConsoleCommand(serverID, command string) error {
+	session := a.getConsole(serverID)
+	if session == nil || !session.ws.IsConnected() {
+		return fmt.Errorf("console not connected")
+	}
+
+	return session.ws.SendCommand(command)
+}
+
+// closePanelConsoles closes the console sessions of all servers of a panel
+func (a *App) closePanelConsoles(panelName string) {
+	a.consolesMu.Lock()
+	var serverIDs []string
+	for serverID, session := range a.consoles {
+		if session.panel == panelName {
+			serverIDs = append(serverIDs, serverID)
+		}
+	}
+	a.consolesMu.Unlock()
+
+	for _, serverID := range serverIDs {
+		a.CloseConsole(serverID)
+	}
+}
+
+// getConsole returns the console session of a server, or nil if none is open
+func (a *App) getConsole(serverID string) *consoleSession {
+	a.consolesMu.Lock()
+	defer a.consolesMu.Unlock()
+	return a.consoles[serverID]
+}
+
+// setActiveConsole selects the server whose console events are also emitted on
+// the unscoped channels ("console-output", ...) used by the single console view
+func (a *App) setActiveConsole(serverID string) {
+	a.consolesMu.Lock()
+	a.activeConsole = serverID
+	_, open := a.consoles[serverID]
+	a.consolesMu.Unlock()
+
+	if a.ctx != nil {
+		runtime.EventsEmit(a.ctx, "console-connected", open)
+	}
+}
+
+// emitConsole emits a console event on the server's channel, and on the
+// unscoped channel if the server is the active console
+func (a *App) emitConsole(serverID, event string, data interface{}) {
+	runtime.EventsEmit(a.ctx, event+":"+serverID, data)
+
+	a.consolesMu.Lock()
+	active := serverID == a.activeConsole
+	a.consolesMu.Unlock()
+
+	if active {
+		runtime.EventsEmit(a.ctx, event, data)
+	}
+}
+
+// panelOrigin returns the Origin header to use for console connections of a panel
+func (a *App) panelOrigin(panelName string) string {
+	for _, panel := range a.config.GetPanels() {
+		if panel.Name == panelName {
+			return strings.TrimSuffix(panelBaseURL(panel.PanelURL), "/")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// consoleHistorySize is the number of lines kept per console session
+	consoleHistorySize = 10000
+	// maxSearchContext caps the context lines returned around a match
+	maxSearchContext = 50
+	// defaultConsoleSearchLimit caps the number of matches returned when no limit is given
+	defaultConsoleSearchLimit = 100
+)
+
+// ConsoleLine represents a line of console output with ANSI codes removed
+type ConsoleLine struct {
+	Seq  uint64    `json:"seq"` // Increases by one per line received in the session
+	Time time.Time `json:"time"`
+	Text string    `json:"text"`
+}
+
+// ConsoleMatch represents a console line matching a search with its context
+type ConsoleMatch struct {
+	ConsoleLine
+	Before []ConsoleLine `json:"before"`
+	After  []ConsoleLine `json:"after"`
+}
+
+// consoleHistory is a ring buffer of the most recent lines of a console session
+type consoleHistory struct {
+	mu    sync.Mutex
+	lines []ConsoleLine
+	next  int    // Index the next line is written to
+	seq   uint64 // Sequence number of the last line
+}
+
+// newConsoleHistory creates a history holding up to size lines
+func newConsoleHistory(size int) *consoleHistory {
+	return &consoleHistory{lines: make([]ConsoleLine, 0, size)}
+}
+
+// add appends console output, split into lines with ANSI codes removed
+func (h *consoleHistory) add(output string) {
+	now := time.Now()
+	text := strings.TrimRight(cleanANSI(output), "\n")
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, line := range strings.Split(text, "\n") {
+		h.seq++
+		entry := ConsoleLine{Seq: h.seq, Time: now, Text: line}
+
+		if len(h.lines) < cap(h.lines) {
+			h.lines = append(h.lines, entry)
+			continue
+		}
+		h.lines[h.next] = entry
+		h.next = (h.next + 1) % len(h.lines)
+	}
+}
+
+// snapshot returns the buffered lines, oldest first
+func (h *consoleHistory) snapshot() []ConsoleLine {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	lines := make([]ConsoleLine, 0, len(h.lines))
+	lines = append(lines, h.lines[h.next:]...)
+	lines = append(lines, h.lines[:h.next]...)
+	return lines
+}
+
+// SearchConsole searches the recent output of an open console session. query
+// is a plain substring, or a Go regular expression if regex is set; both match
+// the output with ANSI codes removed. Each match carries up to contextLines
+// lines before and after it. The most recent matches are returned oldest
+// first; a limit of 0 returns up to 100.
+func (a *App) SearchConsole(serverID, query string, regex, caseSensitive bool, contextLines, limit int) ([]ConsoleMatch, error) {
+	session := a.getConsole(serverID)
+	if session == nil {
+		return nil, fmt.Errorf("console not connected")
+	}
+
+	if query == "" {
+		return nil, fmt.Errorf("search query is required")
+	}
+
+	match, err := consoleMatcher(query, regex, caseSensitive)
+	if err != nil {
+		return nil, err
+	}
+
+	if contextLines < 0 {
+		contextLines = 0
+	} else if contextLines > maxSearchContext {
+		contextLines = maxSearchContext
+	}
+	if limit <= 0 {
+		limit = defaultConsoleSearchLimit
+	}
+
+	lines := session.history.snapshot()
+	matches := []ConsoleMatch{}
+	for i, line := range lines {
+		if !match(line.Text) {
+			continue
+		}
+
+		start := i - contextLines
+		if start < 0 {
+			start = 0
+		}
+		end := i + 1 + contextLines
+		if end > len(lines) {
+			end = len(lines)
+		}
+
+		matches = append(matches, ConsoleMatch{
+			ConsoleLine: line,
+			Before:      lines[start:i],
+			After:       lines[i+1 : end],
+		})
+		if len(matches) > limit {
+			matches = matches[1:]
+		}
+	}
+
+	return matches, nil
+}
+
+// consoleMatcher returns a function reporting whether a line matches query
+func consoleMatcher(query string, regex, caseSensitive bool) (func(string) bool, error) {
+	if regex {
+		if !caseSensitive {
+			query = "(?i)" + query
+		}
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression: %v", err)
+		}
+		return re.MatchString, nil
+	}
+
+	if caseSensitive {
+		return func(line string) bool { return strings.Contains(line, query) }, nil
+	}
+
+	query = strings.ToLower(query)
+	return func(line string) bool { return strings.Contains(strings.ToLower(line), query) }, nil
+}
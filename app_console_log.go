@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"pteroclient-wails/pkg/config"
+	"pteroclient-wails/pkg/consolelog"
+)
+
+// defaultConsoleLogSearchLimit caps the number of matches returned when no limit is given
+const defaultConsoleLogSearchLimit = 500
+
+// consoleLogOptions converts the console log settings to recorder options
+func consoleLogOptions(cfg config.ConsoleLogConfig) consolelog.Options {
+	return consolelog.Options{
+		Rotation: consolelog.Rotation(cfg.Rotation),
+		MaxSize:  int64(cfg.MaxSizeMB) << 20,
+		MaxFiles: cfg.MaxFiles,
+	}
+}
+
+// consoleLogDir returns the directory console logs are captured to
+func (a *App) consoleLogDir() string {
+	return filepath.Join(a.config.GetConfigDir(), "logs")
+}
+
+// applyConsoleLogConfig starts, reconfigures or stops the recorder to match the saved settings
+func (a *App) applyConsoleLogConfig() error {
+	cfg := a.config.GetConsoleLogConfig()
+
+	a.recorderMu.Lock()
+	defer a.recorderMu.Unlock()
+
+	if !cfg.Enabled {
+		if a.recorder == nil {
+			return nil
+		}
+		err := a.recorder.Close()
+		a.recorder = nil
+		return err
+	}
+
+	if a.recorder != nil {
+		a.recorder.SetOptions(consoleLogOptions(cfg))
+		return nil
+	}
+
+	recorder, err := consolelog.NewRecorder(a.consoleLogDir(), consoleLogOptions(cfg))
+	if err != nil {
+		return err
+	}
+	a.recorder = recorder
+	return nil
+}
+
+// recordConsole writes console output of a server to its log if capture is enabled
+func (a *App) recordConsole(serverID, message string) {
+	a.recorderMu.Lock()
+	recorder := a.recorder
+	a.recorderMu.Unlock()
+
+	if recorder == nil {
+		return
+	}
+
+	if err := recorder.Write(serverID, cleanANSI(message)); err != nil && a.ctx != nil {
+		runtime.LogError(a.ctx, fmt.Sprintf("[CONSOLE_LOG] %v", err))
+	}
+}
+
+// logReader returns a recorder for reading captured logs, also when capture is disabled
+func (a *App) logReader() (*consolelog.Recorder, error) {
+	a.recorderMu.Lock()
+	recorder := a.recorder
+	a.recorderMu.Unlock()
+
+	if recorder != nil {
+		return recorder, nil
+	}
+	return consolelog.NewRecorder(a.consoleLogDir(), consolelog.Options{})
+}
+
+// GetConsoleLogSettings returns the console log capture settings
+func (a *App) GetConsoleLogSettings() map[string]interface{} {
+	cfg := a.config.GetConsoleLogConfig()
+	return map[string]interface{}{
+		"enabled":   cfg.Enabled,
+		"rotation":  cfg.Rotation,
+		"maxSizeMB": cfg.MaxSizeMB,
+		"maxFiles":  cfg.MaxFiles,
+		"directory": a.consoleLogDir(),
+	}
+}
+
+// SetConsoleLogSettings enables or disables capturing console output of all
+// open consoles to ~/.pteroclient/logs. rotation is "size" (at maxSizeMB,
+// 10 MB if 0) or "daily"; rotated files are gzipped and maxFiles of them are
+// kept per server (0 keeps all).
+func (a *App) SetConsoleLogSettings(enabled bool, rotation string, maxSizeMB, maxFiles int) error {
+	switch consolelog.Rotation(rotation) {
+	case "":
+		rotation = string(consolelog.RotateSize)
+	case consolelog.RotateSize, consolelog.RotateDaily:
+	default:
+		return fmt.Errorf("invalid rotation %q: must be size or daily", rotation)
+	}
+
+	if maxSizeMB < 0 || maxFiles < 0 {
+		return fmt.Errorf("size and file limits must not be negative")
+	}
+
+	err := a.config.SetConsoleLogConfig(config.ConsoleLogConfig{
+		Enabled:   enabled,
+		Rotation:  rotation,
+		MaxSizeMB: maxSizeMB,
+		MaxFiles:  maxFiles,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.applyConsoleLogConfig()
+}
+
+// ListConsoleLogs lists the captured log files of a server, or of all servers if serverID is empty
+func (a *App) ListConsoleLogs(serverID string) ([]consolelog.LogFile, error) {
+	recorder, err := a.logReader()
+	if err != nil {
+		return nil, err
+	}
+	return recorder.List(serverID)
+}
+
+// SearchConsoleLogs searches the captured logs of a server, or of all servers
+// if serverID is empty, for lines containing query. The most recent matches
+// are returned oldest first; a limit of 0 returns up to 500.
+func (a *App) SearchConsoleLogs(serverID, query string, limit int) ([]consolelog.Match, error) {
+	if limit <= 0 {
+		limit = defaultConsoleLogSearchLimit
+	}
+
+	recorder, err := a.logReader()
+	if err != nil {
+		return nil, err
+	}
+	return recorder.Search(serverID, query, limit)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// databaseToMap converts a database to the map format used by the frontend
+func databaseToMap(d pterodactyl.Database) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              d.ID,
+		"name":            d.Name,
+		"username":        d.Username,
+		"password":        d.Password,
+		"host":            d.Host.Address,
+		"port":            d.Host.Port,
+		"connectionsFrom": d.ConnectionsFrom,
+		"maxConnections":  d.MaxConnections,
+		"jdbcUrl":         d.JDBCURL(),
+	}
+}
+
+// ListDatabases lists databases of any server without switching active server
+func (a *App) ListDatabases(serverID string) ([]map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var databases []pterodactyl.Database
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		databases, err = c.ListDatabasesCtx(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(databases))
+	for i, d := range databases {
+		result[i] = databaseToMap(d)
+	}
+
+	return result, nil
+}
+
+// CreateDatabase creates a database on any server
+// remote is the allowed connection host pattern, "%" allows all hosts
+func (a *App) CreateDatabase(serverID, name, remote string) (map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var db *pterodactyl.Database
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		db, err = c.CreateDatabaseCtx(ctx, name, remote)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return databaseToMap(*db), nil
+}
+
+// RotateDatabasePassword generates a new password for a database on any server
+func (a *App) RotateDatabasePassword(serverID, databaseID string) (map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var db *pterodactyl.Database
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		db, err = c.RotateDatabasePasswordCtx(ctx, databaseID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return databaseToMap(*db), nil
+}
+
+// DeleteDatabase deletes a database from any server
+func (a *App) DeleteDatabase(serverID, databaseID string) error {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		return c.DeleteDatabaseCtx(ctx, databaseID)
+	})
+}
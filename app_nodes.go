@@ -0,0 +1,258 @@
+package main
+
+import (
+	"fmt"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// nodeToMap converts a node to the map format used by the frontend
+func nodeToMap(node pterodactyl.Node) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                 node.ID,
+		"uuid":               node.UUID,
+		"name":               node.Name,
+		"description":        node.Description,
+		"locationId":         node.LocationID,
+		"fqdn":               node.FQDN,
+		"scheme":             node.Scheme,
+		"public":             node.Public,
+		"behindProxy":        node.BehindProxy,
+		"maintenanceMode":    node.MaintenanceMode,
+		"memory":             node.Memory,
+		"memoryOverallocate": node.MemoryOverallocate,
+		"disk":               node.Disk,
+		"diskOverallocate":   node.DiskOverallocate,
+		"uploadSize":         node.UploadSize,
+		"daemonListen":       node.DaemonListen,
+		"daemonSftp":         node.DaemonSFTP,
+		"daemonBase":         node.DaemonBase,
+	}
+}
+
+// nodeResourcesToMap converts node resource totals to the map format used by the frontend
+func nodeResourcesToMap(res pterodactyl.NodeResources) map[string]interface{} {
+	return map[string]interface{}{
+		"servers":     res.Servers,
+		"memory":      res.Memory,
+		"memoryTotal": res.MemoryTotal,
+		"memoryLimit": res.MemoryLimit,
+		"disk":        res.Disk,
+		"diskTotal":   res.DiskTotal,
+		"diskLimit":   res.DiskLimit,
+	}
+}
+
+// locationToMap converts a location to the map format used by the frontend
+func locationToMap(loc pterodactyl.Location) map[string]interface{} {
+	return map[string]interface{}{
+		"id":    loc.ID,
+		"short": loc.Short,
+		"long":  loc.Long,
+	}
+}
+
+// applicationClient returns a client with an application API key for the
+// active panel: the admin key if one is configured, else the panel's own key
+// if it was detected as an application key
+func (a *App) applicationClient() (*pterodactyl.Client, error) {
+	client, adminClient := a.currentClient(), a.currentAdminClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	if adminClient != nil && adminClient.IsAdmin() {
+		return adminClient, nil
+	}
+	if client.IsAdmin() {
+		return client, nil
+	}
+
+	return nil, fmt.Errorf("nodes and locations require an admin API key for this panel")
+}
+
+// ListNodes lists the nodes of the active panel
+func (a *App) ListNodes() ([]map[string]interface{}, error) {
+	client, err := a.applicationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	nodes, err := client.ListNodesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(nodes))
+	for i, node := range nodes {
+		result[i] = nodeToMap(node)
+	}
+
+	return result, nil
+}
+
+// GetNode returns the details of a node of the active panel
+func (a *App) GetNode(nodeID int) (map[string]interface{}, error) {
+	client, err := a.applicationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	node, err := client.GetNodeCtx(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeToMap(*node), nil
+}
+
+// GetNodeConfiguration returns the Wings configuration of a node, including its daemon token
+func (a *App) GetNodeConfiguration(nodeID int) (map[string]interface{}, error) {
+	client, err := a.applicationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.GetNodeConfigurationCtx(ctx, nodeID)
+}
+
+// ListNodeAllocations lists the allocations of a node and whether they are assigned
+func (a *App) ListNodeAllocations(nodeID int) ([]map[string]interface{}, error) {
+	client, err := a.applicationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	allocations, err := client.ListNodeAllocationsCtx(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(allocations))
+	for i, al := range allocations {
+		result[i] = map[string]interface{}{
+			"id":       al.ID,
+			"ip":       al.IP,
+			"alias":    al.Alias,
+			"port":     al.Port,
+			"notes":    al.Notes,
+			"assigned": al.Assigned,
+		}
+	}
+
+	return result, nil
+}
+
+// GetNodeResources returns the memory and disk allocated to a node's servers against its limits
+func (a *App) GetNodeResources(nodeID int) (map[string]interface{}, error) {
+	client, err := a.applicationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	totals, err := client.GetNodeResourcesCtx(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeResourcesToMap(*totals), nil
+}
+
+// ListLocations lists the locations of the active panel
+func (a *App) ListLocations() ([]map[string]interface{}, error) {
+	client, err := a.applicationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	locations, err := client.ListLocationsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(locations))
+	for i, loc := range locations {
+		result[i] = locationToMap(loc)
+	}
+
+	return result, nil
+}
+
+// GetInfrastructure returns the nodes of the active panel with their location,
+// their servers, and the memory and disk allocated to those servers against
+// the node's limits
+func (a *App) GetInfrastructure() ([]map[string]interface{}, error) {
+	client, err := a.applicationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	nodes, err := client.ListNodesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := client.ListLocationsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := client.ListServersCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locationsByID := make(map[int]pterodactyl.Location, len(locations))
+	for _, loc := range locations {
+		locationsByID[loc.ID] = loc
+	}
+
+	serversByNode := make(map[int][]pterodactyl.ServerInfo)
+	for _, s := range servers {
+		serversByNode[s.NodeID] = append(serversByNode[s.NodeID], s)
+	}
+
+	result := make([]map[string]interface{}, len(nodes))
+	for i, node := range nodes {
+		nodeServers := serversByNode[node.ID]
+
+		limits := make([]pterodactyl.ServerLimits, len(nodeServers))
+		for j, s := range nodeServers {
+			limits[j] = s.Limits
+		}
+
+		entry := map[string]interface{}{
+			"node":      nodeToMap(node),
+			"location":  nil,
+			"resources": nodeResourcesToMap(pterodactyl.TotalNodeResources(node, limits)),
+			"servers":   a.serversToMaps(nodeServers),
+		}
+		if loc, ok := locationsByID[node.LocationID]; ok {
+			entry["location"] = locationToMap(loc)
+		}
+		result[i] = entry
+	}
+
+	return result, nil
+}
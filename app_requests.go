@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+)
+
+// requestContext returns the context for the API requests of a single binding
+// call. The caller must call cancel when done. The context is cancelled early
+// when the user navigates away or switches panels, so slow calls such as
+// listing a directory on a hung daemon don't outlive the view that made them.
+func (a *App) requestContext() (context.Context, context.CancelFunc) {
+	a.requestsMu.Lock()
+	defer a.requestsMu.Unlock()
+
+	if a.requestsCtx == nil {
+		a.requestsCtx, a.requestsCancel = context.WithCancel(context.Background())
+	}
+
+	return context.WithCancel(a.requestsCtx)
+}
+
+// batchContext returns the context for bindings whose write takes several
+// requests in a row. Unlike requestContext it is not cancelled by
+// CancelRequests, so navigating away can't stop a batch halfway and leave it
+// only partly applied. It still ends when the application shuts down.
+func (a *App) batchContext() (context.Context, context.CancelFunc) {
+	parent := a.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	return context.WithCancel(parent)
+}
+
+// CancelRequests aborts all API calls still in flight. The frontend calls it
+// when the user leaves a view; switching servers or panels calls it as well.
+// Background transfers, syncs and console sessions are not affected.
+func (a *App) CancelRequests() {
+	a.requestsMu.Lock()
+	defer a.requestsMu.Unlock()
+
+	if a.requestsCancel != nil {
+		a.requestsCancel()
+	}
+	a.requestsCtx, a.requestsCancel = nil, nil
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// serverUsageParallelism bounds how many servers GetServersUsage queries at once
+const serverUsageParallelism = 4
+
+// limitsToMap converts server limits to the map format used by the frontend
+func limitsToMap(limits pterodactyl.ServerLimits) map[string]interface{} {
+	return map[string]interface{}{
+		"memory":      limits.Memory,
+		"swap":        limits.Swap,
+		"disk":        limits.Disk,
+		"io":          limits.IO,
+		"cpu":         limits.CPU,
+		"threads":     limits.Threads,
+		"oomDisabled": limits.OOMDisabled,
+	}
+}
+
+// featureLimitsToMap converts feature limits to the map format used by the frontend
+func featureLimitsToMap(limits pterodactyl.FeatureLimits) map[string]interface{} {
+	return map[string]interface{}{
+		"databases":   limits.Databases,
+		"allocations": limits.Allocations,
+		"backups":     limits.Backups,
+	}
+}
+
+// sftpToMap converts SFTP details to the map format used by the frontend
+func sftpToMap(sftp pterodactyl.SFTPDetails) map[string]interface{} {
+	return map[string]interface{}{
+		"ip":   sftp.IP,
+		"port": sftp.Port,
+	}
+}
+
+// GetResources returns the state and resource usage of any server
+func (a *App) GetResources(serverID string) (map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var resources *pterodactyl.Resources
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		resources, err = c.GetResourcesCtx(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resourcesToMap(resources), nil
+}
+
+// GetServersUsage returns the state and resource usage of several servers at
+// once, so the server list can show live usage next to the limits. Servers
+// are queried a few at a time; the result maps each server ID to its usage,
+// or to a map holding only "error" when that server could not be queried.
+func (a *App) GetServersUsage(serverIDs []string) (map[string]map[string]interface{}, error) {
+	if a.currentClient() == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	// Resolve every client up front so unknown servers refresh the mappings only once
+	clients, errs := a.serverClients(serverIDs)
+
+	usage := make([]map[string]interface{}, len(serverIDs))
+	queue := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < serverUsageParallelism && w < len(serverIDs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				usage[i] = serverUsage(ctx, clients[i])
+			}
+		}()
+	}
+
+	for i := range serverIDs {
+		if errs[i] != nil {
+			usage[i] = map[string]interface{}{"error": errs[i].Error()}
+			continue
+		}
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]map[string]interface{}, len(serverIDs))
+	for i, serverID := range serverIDs {
+		result[serverID] = usage[i]
+	}
+	return result, nil
+}
+
+// serverUsage returns the usage map of one server for GetServersUsage
+func serverUsage(ctx context.Context, client *pterodactyl.Client) map[string]interface{} {
+	if err := ctx.Err(); err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+
+	resources, err := client.GetResourcesCtx(ctx)
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+	return resourcesToMap(resources)
+}
+
+// resourcesToMap converts server resource usage to the map format used by the frontend
+func resourcesToMap(resources *pterodactyl.Resources) map[string]interface{} {
+	return map[string]interface{}{
+		"state":          resources.CurrentState,
+		"isSuspended":    resources.IsSuspended,
+		"memoryBytes":    resources.Resources.MemoryBytes,
+		"cpuAbsolute":    resources.Resources.CPUAbsolute,
+		"diskBytes":      resources.Resources.DiskBytes,
+		"networkRxBytes": resources.Resources.NetworkRxBytes,
+		"networkTxBytes": resources.Resources.NetworkTxBytes,
+		"uptime":         resources.Resources.Uptime,
+	}
+}
+
+// GetServerDetails returns the limits, node, SFTP details, egg and allocations of any server
+func (a *App) GetServerDetails(serverID string) (map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var details *pterodactyl.ServerDetails
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		details, err = c.GetServerDetailsCtx(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allocations := make([]map[string]interface{}, len(details.Allocations))
+	for i, al := range details.Allocations {
+		allocations[i] = allocationToMap(al)
+	}
+
+	return map[string]interface{}{
+		"identifier":             details.Identifier,
+		"uuid":                   details.UUID,
+		"name":                   details.Name,
+		"description":            details.Description,
+		"node":                   details.Node,
+		"isNodeUnderMaintenance": details.IsNodeUnderMaintenance,
+		"sftp":                   sftpToMap(details.SFTP),
+		"limits":                 limitsToMap(details.Limits),
+		"featureLimits":          featureLimitsToMap(details.FeatureLimits),
+		"dockerImage":            details.DockerImage,
+		"invocation":             details.Invocation,
+		"status":                 details.Status,
+		"isSuspended":            details.IsSuspended,
+		"isInstalling":           details.IsInstalling,
+		"isTransferring":         details.IsTransferring,
+		"egg":                    details.Egg.Name,
+		"eggUuid":                details.Egg.UUID,
+		"allocations":            allocations,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// taskToMap converts a schedule task to the map format used by the frontend
+func taskToMap(t pterodactyl.Task) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                t.ID,
+		"sequenceId":        t.SequenceID,
+		"action":            t.Action,
+		"payload":           t.Payload,
+		"timeOffset":        t.TimeOffset,
+		"isQueued":          t.IsQueued,
+		"continueOnFailure": t.ContinueOnFailure,
+	}
+}
+
+// scheduleToMap converts a schedule to the map format used by the frontend
+func scheduleToMap(s pterodactyl.Schedule) map[string]interface{} {
+	tasks := make([]map[string]interface{}, len(s.Tasks))
+	for i, t := range s.Tasks {
+		tasks[i] = taskToMap(t)
+	}
+
+	return map[string]interface{}{
+		"id":             s.ID,
+		"name":           s.Name,
+		"cron":           s.Cron.String(),
+		"isActive":       s.IsActive,
+		"isProcessing":   s.IsProcessing,
+		"onlyWhenOnline": s.OnlyWhenOnline,
+		"lastRunAt":      s.LastRunAt,
+		"nextRunAt":      s.NextRunAt,
+		"tasks":          tasks,
+	}
+}
+
+// ListSchedules lists schedules of the active server
+func (a *App) ListSchedules() ([]map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	schedules, err := client.ListSchedulesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(schedules))
+	for i, s := range schedules {
+		result[i] = scheduleToMap(s)
+	}
+
+	return result, nil
+}
+
+// CreateSchedule creates a schedule on the active server
+// cron is a five field expression, e.g. "0 4 * * *"
+func (a *App) CreateSchedule(name, cron string, isActive, onlyWhenOnline bool) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	parsed, err := pterodactyl.ParseCron(cron)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	schedule, err := client.CreateScheduleCtx(ctx, pterodactyl.ScheduleRequest{
+		Name:           name,
+		Cron:           parsed,
+		IsActive:       isActive,
+		OnlyWhenOnline: onlyWhenOnline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToMap(*schedule), nil
+}
+
+// UpdateSchedule updates a schedule on the active server
+func (a *App) UpdateSchedule(scheduleID int, name, cron string, isActive, onlyWhenOnline bool) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	parsed, err := pterodactyl.ParseCron(cron)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	schedule, err := client.UpdateScheduleCtx(ctx, scheduleID, pterodactyl.ScheduleRequest{
+		Name:           name,
+		Cron:           parsed,
+		IsActive:       isActive,
+		OnlyWhenOnline: onlyWhenOnline,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToMap(*schedule), nil
+}
+
+// DeleteSchedule deletes a schedule from the active server
+func (a *App) DeleteSchedule(scheduleID int) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.DeleteScheduleCtx(ctx, scheduleID)
+}
+
+// ExecuteSchedule runs a schedule of the active server immediately
+func (a *App) ExecuteSchedule(scheduleID int) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.ExecuteScheduleCtx(ctx, scheduleID)
+}
+
+// CreateTask adds a task to a schedule of the active server
+func (a *App) CreateTask(scheduleID int, action, payload string, timeOffset int, continueOnFailure bool) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	task, err := client.CreateTaskCtx(ctx, scheduleID, pterodactyl.TaskRequest{
+		Action:            action,
+		Payload:           payload,
+		TimeOffset:        timeOffset,
+		ContinueOnFailure: continueOnFailure,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskToMap(*task), nil
+}
+
+// UpdateTask updates a task of a schedule on the active server
+func (a *App) UpdateTask(scheduleID, taskID int, action, payload string, timeOffset int, continueOnFailure bool) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	task, err := client.UpdateTaskCtx(ctx, scheduleID, taskID, pterodactyl.TaskRequest{
+		Action:            action,
+		Payload:           payload,
+		TimeOffset:        timeOffset,
+		ContinueOnFailure: continueOnFailure,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return taskToMap(*task), nil
+}
+
+// ReorderTasks sets the execution order of a schedule's tasks on the active server
+func (a *App) ReorderTasks(scheduleID int, taskIDs []int) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	// Each task is moved with its own request, navigating away must not stop halfway
+	ctx, cancel := a.batchContext()
+	defer cancel()
+
+	return client.ReorderTasksCtx(ctx, scheduleID, taskIDs)
+}
+
+// DeleteTask removes a task from a schedule on the active server
+func (a *App) DeleteTask(scheduleID, taskID int) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.DeleteTaskCtx(ctx, scheduleID, taskID)
+}
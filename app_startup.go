@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// startupVariableToMap converts a startup variable to the map format used by the frontend
+func startupVariableToMap(v pterodactyl.StartupVariable) map[string]interface{} {
+	return map[string]interface{}{
+		"name":         v.Name,
+		"description":  v.Description,
+		"envVariable":  v.EnvVariable,
+		"defaultValue": v.DefaultValue,
+		"serverValue":  v.ServerValue,
+		"isEditable":   v.IsEditable,
+		"rules":        v.Rules,
+	}
+}
+
+// GetStartup returns the startup command, docker images and variables of the active server
+func (a *App) GetStartup() (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	startup, err := client.GetStartupCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make([]map[string]interface{}, len(startup.Variables))
+	for i, v := range startup.Variables {
+		variables[i] = startupVariableToMap(v)
+	}
+
+	return map[string]interface{}{
+		"startupCommand":    startup.StartupCommand,
+		"rawStartupCommand": startup.RawStartupCommand,
+		"dockerImages":      startup.DockerImages,
+		"variables":         variables,
+	}, nil
+}
+
+// ValidateStartupVariable checks a value against a variable's rules without saving it
+func (a *App) ValidateStartupVariable(rules, value string) error {
+	return pterodactyl.ValidateRules(rules, value)
+}
+
+// UpdateStartupVariable sets a startup variable of the active server
+func (a *App) UpdateStartupVariable(key, value string) (map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	variable, err := client.UpdateStartupVariableCtx(ctx, key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return startupVariableToMap(*variable), nil
+}
+
+// SetDockerImage changes the docker image of the active server
+func (a *App) SetDockerImage(image string) error {
+	client := a.currentClient()
+	if client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.SetDockerImageCtx(ctx, image)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// statsHistorySize is the number of samples kept per server, about ten
+// minutes at the daemon's stats interval
+const statsHistorySize = 300
+
+// statsRing is a ring buffer of the most recent stats samples of a server
+// It is guarded by App.statsMu.
+type statsRing struct {
+	samples []pterodactyl.Stats
+	next    int // Index the next sample is written to
+}
+
+// newStatsRing creates a ring holding up to size samples
+func newStatsRing(size int) *statsRing {
+	return &statsRing{samples: make([]pterodactyl.Stats, 0, size)}
+}
+
+// add records a sample, replacing the oldest one when the ring is full
+func (r *statsRing) add(stats pterodactyl.Stats) {
+	if len(r.samples) < cap(r.samples) {
+		r.samples = append(r.samples, stats)
+		return
+	}
+	r.samples[r.next] = stats
+	r.next = (r.next + 1) % len(r.samples)
+}
+
+// snapshot returns the recorded samples, oldest first
+func (r *statsRing) snapshot() []pterodactyl.Stats {
+	samples := make([]pterodactyl.Stats, 0, len(r.samples))
+	samples = append(samples, r.samples[r.next:]...)
+	samples = append(samples, r.samples[:r.next]...)
+	return samples
+}
+
+// recordStats appends a stats sample to the server's rolling history
+func (a *App) recordStats(serverID string, stats pterodactyl.Stats) {
+	a.statsMu.Lock()
+	defer a.statsMu.Unlock()
+
+	if a.statsHistory == nil {
+		a.statsHistory = make(map[string]*statsRing)
+	}
+
+	history, ok := a.statsHistory[serverID]
+	if !ok {
+		history = newStatsRing(statsHistorySize)
+		a.statsHistory[serverID] = history
+	}
+	history.add(stats)
+}
+
+// GetStatsHistory returns the recorded stats samples of a server, oldest first
+func (a *App) GetStatsHistory(serverID string) []pterodactyl.Stats {
+	a.statsMu.Lock()
+	defer a.statsMu.Unlock()
+
+	history, ok := a.statsHistory[serverID]
+	if !ok {
+		return []pterodactyl.Stats{}
+	}
+	return history.snapshot()
+}
+
+// ClearStatsHistory drops the recorded stats samples of a server
+func (a *App) ClearStatsHistory(serverID string) {
+	a.statsMu.Lock()
+	defer a.statsMu.Unlock()
+
+	delete(a.statsHistory, serverID)
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"pteroclient-wails/pkg/config"
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// subuserToMap converts a subuser to the map format used by the frontend
+func subuserToMap(u pterodactyl.Subuser) map[string]interface{} {
+	return map[string]interface{}{
+		"uuid":        u.UUID,
+		"username":    u.Username,
+		"email":       u.Email,
+		"image":       u.Image,
+		"twoFactor":   u.TwoFactor,
+		"createdAt":   u.CreatedAt,
+		"permissions": u.Permissions,
+	}
+}
+
+// GetPermissions lists all permission keys that can be granted to subusers
+func (a *App) GetPermissions() ([]map[string]interface{}, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	permissions, err := client.GetPermissionsCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(permissions))
+	for i, p := range permissions {
+		result[i] = map[string]interface{}{
+			"key":         p.Key,
+			"group":       p.Group,
+			"description": p.Description,
+		}
+	}
+
+	return result, nil
+}
+
+// ListSubusers lists subusers of any server without switching active server
+func (a *App) ListSubusers(serverID string) ([]map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var subusers []pterodactyl.Subuser
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		subusers, err = c.ListSubusersCtx(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(subusers))
+	for i, u := range subusers {
+		result[i] = subuserToMap(u)
+	}
+
+	return result, nil
+}
+
+// InviteSubuser gives a user access to any server
+func (a *App) InviteSubuser(serverID, email string, permissions []string) (map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var subuser *pterodactyl.Subuser
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		subuser, err = c.InviteSubuserCtx(ctx, email, permissions)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subuserToMap(*subuser), nil
+}
+
+// UpdateSubuserPermissions replaces the permissions of a subuser on any server
+func (a *App) UpdateSubuserPermissions(serverID, userUUID string, permissions []string) (map[string]interface{}, error) {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	var subuser *pterodactyl.Subuser
+	err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		var err error
+		subuser, err = c.UpdateSubuserPermissionsCtx(ctx, userUUID, permissions)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subuserToMap(*subuser), nil
+}
+
+// RemoveSubuser revokes a subuser's access to any server
+func (a *App) RemoveSubuser(serverID, userUUID string) error {
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+		return c.RemoveSubuserCtx(ctx, userUUID)
+	})
+}
+
+// Permission Template Methods
+
+// GetPermissionTemplates returns all saved permission templates
+func (a *App) GetPermissionTemplates() []map[string]interface{} {
+	templates := a.config.GetPermissionTemplates()
+	result := make([]map[string]interface{}, len(templates))
+
+	for i, t := range templates {
+		result[i] = map[string]interface{}{
+			"name":        t.Name,
+			"permissions": t.Permissions,
+		}
+	}
+
+	return result
+}
+
+// SavePermissionTemplate adds or updates a permission template
+func (a *App) SavePermissionTemplate(name string, permissions []string) error {
+	if name == "" {
+		return fmt.Errorf("template name is required")
+	}
+	if len(permissions) == 0 {
+		return fmt.Errorf("template must contain at least one permission")
+	}
+
+	return a.config.AddOrUpdatePermissionTemplate(config.PermissionTemplate{
+		Name:        name,
+		Permissions: permissions,
+	})
+}
+
+// DeletePermissionTemplate removes a permission template
+func (a *App) DeletePermissionTemplate(name string) error {
+	return a.config.RemovePermissionTemplate(name)
+}
+
+// ApplyPermissionTemplate grants a template's permissions to a user on several servers
+// Existing subusers get their permissions replaced, others are invited.
+// The result maps each server ID to "invited", "updated" or an error message.
+// Navigating away does not cancel the batch, so every server gets an outcome.
+func (a *App) ApplyPermissionTemplate(templateName, email string, serverIDs []string) (map[string]string, error) {
+	template := a.config.GetPermissionTemplate(templateName)
+	if template == nil {
+		return nil, fmt.Errorf("permission template not found: %s", templateName)
+	}
+	if email == "" {
+		return nil, fmt.Errorf("email is required")
+	}
+
+	ctx, cancel := a.batchContext()
+	defer cancel()
+
+	results := make(map[string]string, len(serverIDs))
+	for _, serverID := range serverIDs {
+		var outcome string
+		err := a.withServerClient(serverID, func(c *pterodactyl.Client) error {
+			subusers, err := c.ListSubusersCtx(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, u := range subusers {
+				if strings.EqualFold(u.Email, email) {
+					outcome = "updated"
+					_, err := c.UpdateSubuserPermissionsCtx(ctx, u.UUID, template.Permissions)
+					return err
+				}
+			}
+
+			outcome = "invited"
+			_, err = c.InviteSubuserCtx(ctx, email, template.Permissions)
+			return err
+		})
+
+		if err != nil {
+			results[serverID] = err.Error()
+		} else {
+			results[serverID] = outcome
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// syncOptions validates the sync arguments shared by PlanSync and SyncFolder
+func syncOptions(localDir, mode string, deleteExtra, hash bool, exclude []string) (pterodactyl.SyncOptions, error) {
+	info, err := os.Stat(localDir)
+	if err != nil {
+		return pterodactyl.SyncOptions{}, fmt.Errorf("failed to read local directory: %w", err)
+	}
+	if !info.IsDir() {
+		return pterodactyl.SyncOptions{}, fmt.Errorf("%s is not a directory", localDir)
+	}
+
+	return pterodactyl.SyncOptions{
+		Mode:        pterodactyl.SyncMode(mode),
+		Delete:      deleteExtra,
+		Hash:        hash,
+		Exclude:     exclude,
+		Parallelism: transferParallelism,
+	}, nil
+}
+
+// syncStatePath returns the file that keeps the mirror state of a local folder and a
+// directory of a server, under ~/.pteroclient/sync so the synced folder stays clean
+func (a *App) syncStatePath(serverID, localDir, remoteDir string) string {
+	if abs, err := filepath.Abs(localDir); err == nil {
+		localDir = abs
+	}
+	key := a.config.GetActivePanelName() + "\x00" + serverID + "\x00" + localDir + "\x00" + path.Clean("/"+remoteDir)
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(a.config.GetConfigDir(), "sync", hex.EncodeToString(sum[:16])+".json")
+}
+
+// loadSyncState reads a saved mirror state, returning nil if there is none
+// A missing or unreadable state makes the next mirror sync compare by time again.
+func loadSyncState(statePath string) *pterodactyl.SyncState {
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		return nil
+	}
+
+	var state pterodactyl.SyncState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil
+	}
+	return &state
+}
+
+// saveSyncState writes a mirror state for the next sync of the same folders
+func saveSyncState(statePath string, state *pterodactyl.SyncState) error {
+	if err := os.MkdirAll(filepath.Dir(statePath), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(statePath, data, 0644)
+}
+
+// PlanSync compares a local folder with a directory of the active server and returns
+// the planned operations without changing anything (dry run)
+// mode is "push", "pull" or "mirror"; deleteExtra removes files missing on the source side,
+// or in mirror mode files deleted on one side since the last mirror sync of these folders;
+// hash compares contents when only the times differ; exclude holds glob patterns such as ".git".
+func (a *App) PlanSync(localDir, remoteDir, mode string, deleteExtra, hash bool, exclude []string) (*pterodactyl.SyncReport, error) {
+	client := a.currentClient()
+	if client == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	opts, err := syncOptions(localDir, mode, deleteExtra, hash, exclude)
+	if err != nil {
+		return nil, err
+	}
+	opts.DryRun = true
+	if opts.Mode == pterodactyl.SyncMirror {
+		opts.State = loadSyncState(a.syncStatePath(client.GetServerID(), localDir, remoteDir))
+	}
+
+	ctx, cancel := a.requestContext()
+	defer cancel()
+
+	return client.SyncCtx(ctx, localDir, remoteDir, opts)
+}
+
+// SyncFolder syncs a local folder with a directory of the active server in the background
+// Arguments match PlanSync. It returns a transfer ID; the final transfer event carries the
+// executed operations in "results".
+func (a *App) SyncFolder(localDir, remoteDir, mode string, deleteExtra, hash bool, exclude []string) (string, error) {
+	client := a.currentClient()
+	if client == nil {
+		return "", fmt.Errorf("not connected")
+	}
+
+	opts, err := syncOptions(localDir, mode, deleteExtra, hash, exclude)
+	if err != nil {
+		return "", err
+	}
+
+	statePath := a.syncStatePath(client.GetServerID(), localDir, remoteDir)
+	if opts.Mode == pterodactyl.SyncMirror {
+		opts.State = loadSyncState(statePath)
+	}
+
+	id, ctx := a.startTransfer()
+
+	go func() {
+		defer a.finishTransfer(id)
+
+		report, err := client.SyncCtx(ctx, localDir, remoteDir, opts)
+		if err != nil {
+			a.emitTransferResult(id, 0, nil, err)
+			return
+		}
+
+		if report.State != nil {
+			if err := saveSyncState(statePath, report.State); err != nil && a.ctx != nil {
+				runtime.LogError(a.ctx, "Failed to save sync state: "+err.Error())
+			}
+		}
+
+		var bytes int64
+		failed := 0
+		for _, r := range report.Results {
+			switch {
+			case r.Error != "":
+				failed++
+			case r.Action == pterodactyl.SyncUpload || r.Action == pterodactyl.SyncDownload:
+				bytes += r.Size
+			}
+		}
+
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		} else if failed > 0 {
+			err = fmt.Errorf("%d of %d operations failed", failed, len(report.Results))
+		}
+
+		a.emitTransferResult(id, bytes, report.Results, err)
+	}()
+
+	return id, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+func TestCleanANSI(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain text", "hello world", "hello world"},
+		{"newlines and tabs", "a\tb\nc", "a\tb\nc"},
+		{"color", "\x1b[31mred\x1b[0m", "red"},
+		{"CSI with parameters", "\x1b[1;38;5;208mbold\x1b[m", "bold"},
+		{"CSI private parameters", "\x1b[?25lhidden\x1b[?25h", "hidden"},
+		{"cursor movement", "a\x1b[2K\x1b[1Gb", "ab"},
+		{"OSC ended by BEL", "\x1b]0;title\x07text", "text"},
+		{"OSC ended by ST", "\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"DCS string", "\x1bPq#0;2;0;0;0\x1b\\after", "after"},
+		{"8-bit CSI", "\u009b32mgreen\u009b0m", "green"},
+		{"two-byte escape", "\x1b7saved\x1b8", "saved"},
+		{"charset escape", "\x1b(Bascii", "ascii"},
+		{"unterminated ESC", "text\x1b", "text"},
+		{"unterminated CSI", "text\x1b[31", "text"},
+		{"unterminated OSC", "text\x1b]0;title", "text"},
+		{"unterminated 8-bit CSI", "text\u009b1;2", "text"},
+		{"malformed CSI keeps following text", "\x1b[31\nnext", "\nnext"},
+		{"CRLF", "line one\r\nline two\r\n", "line one\nline two\n"},
+		{"lone CR", "50%\r100%", "50%\n100%"},
+		{"trailing CR", "done\r", "done"},
+		{"bell and backspace", "a\x07b\x08c", "abc"},
+		{"delete", "a\x7fb", "ab"},
+		{"UTF-8 passthrough", "héllo ✓ 日本語 🎮", "héllo ✓ 日本語 🎮"},
+		{"UTF-8 inside colors", "\x1b[33m⚠ warnung\x1b[0m", "⚠ warnung"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cleanANSI(tt.in); got != tt.want {
+				t.Errorf("cleanANSI(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerClientFallsBackToActiveServer(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	// Listings map servers by UUID, the active server is configured by identifier
+	a := NewApp()
+	a.clients = newClientPool()
+	a.clients.setPanelServers("main", []pterodactyl.ServerInfo{{ID: "1a7ce997-2d63-4a0f-9f8e-3b1b0f0c5a4e"}})
+	active := pterodactyl.NewClient(srv.URL, "key", "1a7ce997")
+	defer active.Close()
+	a.setClients(active, nil)
+
+	client, err := a.serverClient("1a7ce997")
+	if err != nil {
+		t.Fatalf("serverClient() = %v", err)
+	}
+	if client != active {
+		t.Error("serverClient() did not return the active client for the active server")
+	}
+}
+
+func TestStatsHistoryKeepsLatestSamples(t *testing.T) {
+	a := NewApp()
+	for i := 0; i < statsHistorySize+50; i++ {
+		a.recordStats("abc123", pterodactyl.Stats{MemoryBytes: int64(i)})
+	}
+
+	history := a.GetStatsHistory("abc123")
+	if len(history) != statsHistorySize {
+		t.Fatalf("kept %d samples, want %d", len(history), statsHistorySize)
+	}
+	for i, s := range history {
+		if want := int64(i + 50); s.MemoryBytes != want {
+			t.Fatalf("sample %d = %d, want %d", i, s.MemoryBytes, want)
+		}
+	}
+
+	if got := a.GetStatsHistory("unknown"); got == nil || len(got) != 0 {
+		t.Errorf("GetStatsHistory() of an unknown server = %v, want empty", got)
+	}
+}
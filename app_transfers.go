@@ -0,0 +1,446 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+const (
+	// transferProgressInterval limits how often progress events are emitted per transfer
+	transferProgressInterval = 250 * time.Millisecond
+	// maxUploadAttempts is how often an upload is tried before giving up
+	maxUploadAttempts = 3
+	// uploadRetryDelay is multiplied by the attempt number between upload attempts
+	uploadRetryDelay = 2 * time.Second
+	// transferParallelism is the number of files moved at once in directory transfers
+	transferParallelism = 4
+)
+
+// progressWriter counts the bytes written through it and reports progress at a limited rate
+type progressWriter struct {
+	w          io.Writer
+	written    int64
+	lastReport time.Time
+	report     func(written int64)
+}
+
+// Write writes p to the underlying writer and reports progress when due
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.written += int64(n)
+	if time.Since(pw.lastReport) >= transferProgressInterval {
+		pw.lastReport = time.Now()
+		pw.report(pw.written)
+	}
+	return n, err
+}
+
+// progressReader counts the bytes read through it and reports progress at a limited rate
+type progressReader struct {
+	r          io.Reader
+	read       int64
+	lastReport time.Time
+	report     func(read int64)
+}
+
+// Read reads from the underlying reader and reports progress when due
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	pr.read += int64(n)
+	if time.Since(pr.lastReport) >= transferProgressInterval {
+		pr.lastReport = time.Now()
+		pr.report(pr.read)
+	}
+	return n, err
+}
+
+// startTransfer registers a new cancellable transfer and returns its ID and context
+func (a *App) startTransfer() (string, context.Context) {
+	parent := a.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+
+	a.transfersMu.Lock()
+	defer a.transfersMu.Unlock()
+
+	if a.transfers == nil {
+		a.transfers = make(map[string]context.CancelFunc)
+	}
+	a.transferSeq++
+	id := fmt.Sprintf("transfer-%d", a.transferSeq)
+	a.transfers[id] = cancel
+
+	return id, ctx
+}
+
+// finishTransfer releases a transfer's context and forgets it
+func (a *App) finishTransfer(id string) {
+	a.transfersMu.Lock()
+	defer a.transfersMu.Unlock()
+
+	if cancel, ok := a.transfers[id]; ok {
+		cancel()
+		delete(a.transfers, id)
+	}
+}
+
+// emitTransferResult emits the completion, cancellation or error event of a transfer
+// results carries the per-file report of directory transfers and syncs and is nil for single files
+func (a *App) emitTransferResult(id string, bytes int64, results interface{}, err error) {
+	if a.ctx == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"id":    id,
+		"bytes": bytes,
+	}
+	if results != nil {
+		data["results"] = results
+	}
+
+	switch {
+	case err == nil:
+		runtime.EventsEmit(a.ctx, "transfer-complete", data)
+	case errors.Is(err, context.Canceled):
+		runtime.EventsEmit(a.ctx, "transfer-cancelled", data)
+	default:
+		data["error"] = err.Error()
+		runtime.EventsEmit(a.ctx, "transfer-error", data)
+	}
+}
+
+// CancelTransfer cancels a running download or upload
+func (a *App) CancelTransfer(id string) error {
+	a.transfersMu.Lock()
+	cancel, ok := a.transfers[id]
+	a.transfersMu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("transfer not found: %s", id)
+	}
+
+	cancel()
+	return nil
+}
+
+// DownloadFileToLocal downloads a file of the active server to a local path in the background
+// It returns a transfer ID right away. Progress is reported through "transfer-progress"
+// events and the result through "transfer-complete", "transfer-cancelled" or "transfer-error".
+func (a *App) DownloadFileToLocal(remotePath, localPath string) (string, error) {
+	client := a.currentClient()
+	if client == nil {
+		return "", fmt.Errorf("not connected")
+	}
+	if localPath == "" {
+		return "", fmt.Errorf("local path is required")
+	}
+
+	id, ctx := a.startTransfer()
+
+	go func() {
+		defer a.finishTransfer(id)
+		bytes, err := a.downloadToLocal(ctx, client, id, remotePath, localPath)
+		a.emitTransferResult(id, bytes, nil, err)
+	}()
+
+	return id, nil
+}
+
+// downloadToLocal streams a remote file into a temporary file and moves it into place when complete
+func (a *App) downloadToLocal(ctx context.Context, client *pterodactyl.Client, id, remotePath, localPath string) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return 0, fmt.Errorf("failed to create local directory: %w", err)
+	}
+
+	body, total, err := client.OpenDownloadCtx(ctx, remotePath)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	// Write to a partial file so an aborted download never looks complete
+	tmpPath := localPath + ".part"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create local file: %w", err)
+	}
+
+	pw := &progressWriter{
+		w: file,
+		report: func(written int64) {
+			if a.ctx != nil {
+				runtime.EventsEmit(a.ctx, "transfer-progress", map[string]interface{}{
+					"id":         id,
+					"direction":  "download",
+					"remotePath": remotePath,
+					"localPath":  localPath,
+					"bytes":      written,
+					"total":      total,
+				})
+			}
+		},
+	}
+
+	_, err = io.Copy(pw, body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		if ctx.Err() != nil {
+			return pw.written, ctx.Err()
+		}
+		return pw.written, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, localPath); err != nil {
+		os.Remove(tmpPath)
+		return pw.written, fmt.Errorf("failed to move downloaded file into place: %w", err)
+	}
+
+	pw.report(pw.written)
+	return pw.written, nil
+}
+
+// UploadLocalFile uploads a local file into a directory of the active server in the background
+// The file is streamed from disk. A failed upload is retried from scratch unless the failed
+// attempt still wrote the file, or the panel rejected it (4xx). Events are the same as for
+// DownloadFileToLocal.
+func (a *App) UploadLocalFile(localPath, remoteDir string) (string, error) {
+	client := a.currentClient()
+	if client == nil {
+		return "", fmt.Errorf("not connected")
+	}
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read local file: %w", err)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s is a directory", localPath)
+	}
+	if remoteDir == "" {
+		remoteDir = "/"
+	}
+
+	id, ctx := a.startTransfer()
+
+	go func() {
+		defer a.finishTransfer(id)
+		err := a.uploadFromLocal(ctx, client, id, localPath, remoteDir, info.Size())
+		a.emitTransferResult(id, info.Size(), nil, err)
+	}()
+
+	return id, nil
+}
+
+// uploadFromLocal uploads a local file, retrying failed attempts from the beginning
+func (a *App) uploadFromLocal(ctx context.Context, client *pterodactyl.Client, id, localPath, remoteDir string, size int64) error {
+	filename := filepath.Base(localPath)
+	remotePath := strings.TrimSuffix(remoteDir, "/") + "/" + filename
+
+	var lastErr error
+	var started time.Time
+	for attempt := 1; attempt <= maxUploadAttempts; attempt++ {
+		if attempt > 1 {
+			// The upload may have completed even though the response was lost
+			if uploadLanded(ctx, client, remotePath, size, started) {
+				return nil
+			}
+
+			if a.ctx != nil {
+				runtime.EventsEmit(a.ctx, "transfer-retry", map[string]interface{}{
+					"id":      id,
+					"attempt": attempt,
+					"error":   lastErr.Error(),
+				})
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt-1) * uploadRetryDelay):
+			}
+		}
+
+		started = time.Now()
+		lastErr = a.uploadAttempt(ctx, client, id, localPath, remotePath, size)
+		if lastErr == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if pterodactyl.IsClientError(lastErr) {
+			// Rejected by the panel, e.g. forbidden, too large or invalid; retrying won't help
+			return lastErr
+		}
+	}
+
+	return lastErr
+}
+
+// uploadLanded reports whether an upload attempt that started at started wrote the
+// file anyway. An older remote file of the same size must not count, so the file has
+// to be modified after the attempt started. Contents are not compared, since that
+// would download the whole file again.
+func uploadLanded(ctx context.Context, client *pterodactyl.Client, remotePath string, size int64, started time.Time) bool {
+	remote, err := client.StatFileCtx(ctx, remotePath)
+	if err != nil || remote == nil || remote.Size != size {
+		return false
+	}
+
+	// Modification times have a resolution of one second
+	return !remote.ModifiedAt.Before(started.Truncate(time.Second))
+}
+
+// uploadAttempt streams a local file to the server once
+func (a *App) uploadAttempt(ctx context.Context, client *pterodactyl.Client, id, localPath, remotePath string, size int64) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to open local file: %w", err)
+	}
+	defer file.Close()
+
+	pr := &progressReader{
+		r: file,
+		report: func(read int64) {
+			if a.ctx != nil {
+				runtime.EventsEmit(a.ctx, "transfer-progress", map[string]interface{}{
+					"id":         id,
+					"direction":  "upload",
+					"remotePath": remotePath,
+					"localPath":  localPath,
+					"bytes":      read,
+					"total":      size,
+				})
+			}
+		},
+	}
+
+	dir, filename := path.Split(remotePath)
+	if err := client.UploadStreamCtx(ctx, dir, filename, pr, size); err != nil {
+		return err
+	}
+
+	pr.report(pr.read)
+	return nil
+}
+
+// UploadDirectory uploads a local folder into a directory of the active server in the background
+// Missing remote directories are created. Each finished file emits a "transfer-file" event and
+// the final event carries the per-file report in "results".
+func (a *App) UploadDirectory(localDir, remoteDir string) (string, error) {
+	client := a.currentClient()
+	if client == nil {
+		return "", fmt.Errorf("not connected")
+	}
+
+	info, err := os.Stat(localDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to read local directory: %w", err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", localDir)
+	}
+
+	// Upload into a folder named like the local one, as a file manager would
+	target := strings.TrimSuffix(remoteDir, "/") + "/" + filepath.Base(localDir)
+
+	id, ctx := a.startTransfer()
+
+	go func() {
+		defer a.finishTransfer(id)
+		results, err := client.UploadDirectoryCtx(ctx, localDir, target, transferParallelism, a.fileTransferReporter(id, "upload"))
+		a.emitTransferResult(id, transferredBytes(results), results, transferError(ctx, results, err))
+	}()
+
+	return id, nil
+}
+
+// DownloadDirectory downloads a directory of the active server into a local folder in the background
+// Events are the same as for UploadDirectory.
+func (a *App) DownloadDirectory(remoteDir, localDir string) (string, error) {
+	client := a.currentClient()
+	if client == nil {
+		return "", fmt.Errorf("not connected")
+	}
+	if localDir == "" {
+		return "", fmt.Errorf("local path is required")
+	}
+
+	_, name := splitPath(strings.TrimSuffix(remoteDir, "/"))
+	if name == "" {
+		name = "server"
+	}
+	target := filepath.Join(localDir, name)
+
+	id, ctx := a.startTransfer()
+
+	go func() {
+		defer a.finishTransfer(id)
+		results, err := client.DownloadDirectoryCtx(ctx, remoteDir, target, transferParallelism, a.fileTransferReporter(id, "download"))
+		a.emitTransferResult(id, transferredBytes(results), results, transferError(ctx, results, err))
+	}()
+
+	return id, nil
+}
+
+// fileTransferReporter returns a callback emitting a "transfer-file" event for each finished file
+func (a *App) fileTransferReporter(id, direction string) func(pterodactyl.FileTransferResult) {
+	return func(result pterodactyl.FileTransferResult) {
+		if a.ctx != nil {
+			runtime.EventsEmit(a.ctx, "transfer-file", map[string]interface{}{
+				"id":        id,
+				"direction": direction,
+				"result":    result,
+			})
+		}
+	}
+}
+
+// transferredBytes sums the bytes of all successful files of a directory transfer
+func transferredBytes(results []pterodactyl.FileTransferResult) int64 {
+	var total int64
+	for _, r := range results {
+		if r.Error == "" {
+			total += r.Bytes
+		}
+	}
+	return total
+}
+
+// transferError summarizes the outcome of a directory transfer as a single error
+func transferError(ctx context.Context, results []pterodactyl.FileTransferResult, err error) error {
+	if err != nil {
+		return err
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files failed", failed, len(results))
+	}
+
+	return nil
+}
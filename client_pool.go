@@ -0,0 +1,154 @@
+package main
+
+import (
+	"strings"
+	"sync"
+
+	"pteroclient-wails/pkg/config"
+	"pteroclient-wails/pkg/pterodactyl"
+)
+
+// clientPool holds one long-lived client per panel, plus one for the panel's
+// admin key if it has one, and the panel of every known server. It is safe for
+// concurrent use; per-server clients are derived with Client.ForServer instead
+// of changing a shared client's server ID.
+type clientPool struct {
+	mu           sync.RWMutex
+	clients      map[string]*pooledClient // By panel name
+	admins       map[string]*pooledClient // Admin key clients by panel name
+	serverPanels map[string]string        // Panel name by server ID
+}
+
+// pooledClient is a panel client with the settings it was created with
+type pooledClient struct {
+	client   *pterodactyl.Client
+	panelURL string
+	apiKey   string
+}
+
+// newClientPool creates an empty client pool
+func newClientPool() *clientPool {
+	return &clientPool{
+		clients:      make(map[string]*pooledClient),
+		admins:       make(map[string]*pooledClient),
+		serverPanels: make(map[string]string),
+	}
+}
+
+// panelBaseURL returns the panel URL with a protocol, defaulting to https
+func panelBaseURL(panelURL string) string {
+	if !strings.HasPrefix(panelURL, "http://") && !strings.HasPrefix(panelURL, "https://") {
+		return "https://" + panelURL
+	}
+	return panelURL
+}
+
+// panelClient returns the client of a panel, creating it on first use or when
+// the panel's URL or API key changed
+func (p *clientPool) panelClient(panel config.PanelConfig) *pterodactyl.Client {
+	return p.pooled(p.clients, panel.Name, panelBaseURL(panel.PanelURL), panel.APIKey, panel.RequestBudget)
+}
+
+// panelAdminClient returns the admin key client of a panel like panelClient,
+// or nil if the panel has no admin key
+func (p *clientPool) panelAdminClient(panel config.PanelConfig) *pterodactyl.Client {
+	if panel.AdminKey == "" {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if current, ok := p.admins[panel.Name]; ok {
+			current.client.Close()
+			delete(p.admins, panel.Name)
+		}
+		return nil
+	}
+	return p.pooled(p.admins, panel.Name, panelBaseURL(panel.PanelURL), panel.AdminKey, panel.RequestBudget)
+}
+
+// pooled returns the client of a panel from clients, creating it on first use
+// or when the panel's URL or key changed
+func (p *clientPool) pooled(clients map[string]*pooledClient, panelName, panelURL, apiKey string, budget int) *pterodactyl.Client {
+	p.mu.RLock()
+	pooled, ok := clients[panelName]
+	p.mu.RUnlock()
+	if ok && pooled.panelURL == panelURL && pooled.apiKey == apiKey {
+		pooled.client.SetRequestBudget(budget)
+		return pooled.client
+	}
+
+	// Creating a client detects the API type over the network, so don't hold the lock
+	client := pterodactyl.NewClient(panelURL, apiKey, "")
+	client.SetRequestBudget(budget)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if current, ok := clients[panelName]; ok {
+		if current.panelURL == panelURL && current.apiKey == apiKey {
+			// Created concurrently, keep the first one
+			client.Close()
+			return current.client
+		}
+		current.client.Close()
+	}
+
+	clients[panelName] = &pooledClient{client: client, panelURL: panelURL, apiKey: apiKey}
+	return client
+}
+
+// setRequestBudget applies a request budget to the running clients of a panel
+func (p *clientPool) setRequestBudget(panelName string, perMinute int) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if pooled, ok := p.clients[panelName]; ok {
+		pooled.client.SetRequestBudget(perMinute)
+	}
+	if pooled, ok := p.admins[panelName]; ok {
+		pooled.client.SetRequestBudget(perMinute)
+	}
+}
+
+// panelOf returns the panel a server belongs to
+func (p *clientPool) panelOf(serverID string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	panelName, ok := p.serverPanels[serverID]
+	return panelName, ok
+}
+
+// setPanelServers replaces the servers known to belong to a panel
+func (p *clientPool) setPanelServers(panelName string, servers []pterodactyl.ServerInfo) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for serverID, name := range p.serverPanels {
+		if name == panelName {
+			delete(p.serverPanels, serverID)
+		}
+	}
+	for _, s := range servers {
+		p.serverPanels[s.ID] = panelName
+	}
+}
+
+// removePanel closes the clients of a panel and forgets its servers
+func (p *clientPool) removePanel(panelName string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if pooled, ok := p.clients[panelName]; ok {
+		pooled.client.Close()
+		delete(p.clients, panelName)
+	}
+	if pooled, ok := p.admins[panelName]; ok {
+		pooled.client.Close()
+		delete(p.admins, panelName)
+	}
+	for serverID, name := range p.serverPanels {
+		if name == panelName {
+			delete(p.serverPanels, serverID)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"pteroclient-wails/pkg/config"
+)
+
+func TestClientPoolAdminClients(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	pool := newClientPool()
+	panel := config.PanelConfig{Name: "main", PanelURL: srv.URL, APIKey: "client-key", AdminKey: "admin-key", RequestBudget: 60}
+
+	admin := pool.panelAdminClient(panel)
+	if admin == nil {
+		t.Fatal("panelAdminClient() = nil for a panel with an admin key")
+	}
+	if again := pool.panelAdminClient(panel); again != admin {
+		t.Error("panelAdminClient() created a new client for an unchanged panel")
+	}
+	if got := admin.RateLimit().Budget; got != 60 {
+		t.Errorf("admin client budget = %d, want 60", got)
+	}
+
+	client := pool.panelClient(panel)
+	pool.setRequestBudget("main", 30)
+	if got := client.RateLimit().Budget; got != 30 {
+		t.Errorf("client budget after setRequestBudget = %d, want 30", got)
+	}
+	if got := admin.RateLimit().Budget; got != 30 {
+		t.Errorf("admin client budget after setRequestBudget = %d, want 30", got)
+	}
+
+	panel.AdminKey = ""
+	if got := pool.panelAdminClient(panel); got != nil {
+		t.Error("panelAdminClient() returned a client after the admin key was removed")
+	}
+}
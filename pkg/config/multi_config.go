@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 )
 
 // PanelConfig represents configuration for a single panel
@@ -14,20 +15,39 @@ type PanelConfig struct {
 	APIKey    string `json:"api_key"`
 	AdminKey  string `json:"admin_key,omitempty"` // Optional admin API key for listing all servers
 	ServerID  string `json:"server_id,omitempty"`
+	RequestBudget int `json:"request_budget,omitempty"` // Max API requests per minute, 0 for no limit
+}
+
+// PermissionTemplate represents a named set of subuser permissions
+type PermissionTemplate struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// ConsoleLogConfig represents the console log capture settings
+type ConsoleLogConfig struct {
+	Enabled   bool   `json:"enabled"`
+	Rotation  string `json:"rotation,omitempty"`    // "size" or "daily"
+	MaxSizeMB int    `json:"max_size_mb,omitempty"` // File size limit for size rotation
+	MaxFiles  int    `json:"max_files,omitempty"`   // Rotated files kept per server, 0 keeps all
 }
 
 // MultiConfig represents the multi-panel configuration
 type MultiConfig struct {
 	Panels      []PanelConfig `json:"panels"`
 	ActivePanel string        `json:"active_panel"`
+	PermissionTemplates []PermissionTemplate `json:"permission_templates,omitempty"`
+	ConsoleLog  *ConsoleLogConfig `json:"console_log,omitempty"`
 	// Legacy fields for backward compatibility
 	LegacyPanelURL string `json:"panel_url,omitempty"`
 	LegacyAPIKey   string `json:"api_key,omitempty"`
 	LegacyServerID string `json:"server_id,omitempty"`
 }
 
-// MultiConfigManager manages multi-panel configuration
+// MultiConfigManager manages multi-panel configuration. It is safe for
+// concurrent use; getters return copies of the stored configuration.
 type MultiConfigManager struct {
+	mu         sync.RWMutex
 	configPath string
 	config     *MultiConfig
 }
@@ -60,6 +80,9 @@ func NewMultiConfigManager() (*MultiConfigManager, error) {
 
 // Load loads configuration from file
 func (mcm *MultiConfigManager) Load() error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
 	data, err := os.ReadFile(mcm.configPath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -106,7 +129,7 @@ func (mcm *MultiConfigManager) Load() error {
 				ActivePanel: "Default",
 			}
 			// Save in new format
-			mcm.Save()
+			mcm.save()
 		} else {
 			return fmt.Errorf("failed to parse config file: %w", err)
 		}
@@ -117,6 +140,13 @@ func (mcm *MultiConfigManager) Load() error {
 
 // Save saves configuration to file
 func (mcm *MultiConfigManager) Save() error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	return mcm.save()
+}
+
+// save writes the configuration to file, the caller must hold mu
+func (mcm *MultiConfigManager) save() error {
 	data, err := json.MarshalIndent(mcm.config, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
@@ -129,8 +159,22 @@ func (mcm *MultiConfigManager) Save() error {
 	return nil
 }
 
-// GetActivePanel returns the active panel configuration
+// GetActivePanel returns a copy of the active panel configuration
 func (mcm *MultiConfigManager) GetActivePanel() *PanelConfig {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
+	panel := mcm.activePanel()
+	if panel == nil {
+		return nil
+	}
+	
+	copied := *panel
+	return &copied
+}
+
+// activePanel returns the active panel configuration, the caller must hold mu
+func (mcm *MultiConfigManager) activePanel() *PanelConfig {
 	if mcm.config == nil || mcm.config.ActivePanel == "" {
 		return nil
 	}
@@ -152,6 +196,9 @@ func (mcm *MultiConfigManager) GetActivePanel() *PanelConfig {
 
 // AddOrUpdatePanel adds or updates a panel configuration
 func (mcm *MultiConfigManager) AddOrUpdatePanel(panel PanelConfig) error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
 	if mcm.config == nil {
 		mcm.config = &MultiConfig{
 			Panels: []PanelConfig{},
@@ -163,7 +210,7 @@ func (mcm *MultiConfigManager) AddOrUpdatePanel(panel PanelConfig) error {
 		if p.Name == panel.Name {
 			// Update existing panel
 			mcm.config.Panels[i] = panel
-			return mcm.Save()
+			return mcm.save()
 		}
 	}
 	
@@ -175,11 +222,14 @@ func (mcm *MultiConfigManager) AddOrUpdatePanel(panel PanelConfig) error {
 		mcm.config.ActivePanel = panel.Name
 	}
 	
-	return mcm.Save()
+	return mcm.save()
 }
 
 // RemovePanel removes a panel configuration
 func (mcm *MultiConfigManager) RemovePanel(name string) error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
 	if mcm.config == nil {
 		return nil
 	}
@@ -201,11 +251,14 @@ func (mcm *MultiConfigManager) RemovePanel(name string) error {
 		}
 	}
 	
-	return mcm.Save()
+	return mcm.save()
 }
 
 // SetActivePanel sets the active panel
 func (mcm *MultiConfigManager) SetActivePanel(name string) error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
 	if mcm.config == nil {
 		return fmt.Errorf("config not initialized")
 	}
@@ -214,7 +267,7 @@ func (mcm *MultiConfigManager) SetActivePanel(name string) error {
 	for _, p := range mcm.config.Panels {
 		if p.Name == name {
 			mcm.config.ActivePanel = name
-			return mcm.Save()
+			return mcm.save()
 		}
 	}
 	
@@ -223,14 +276,20 @@ func (mcm *MultiConfigManager) SetActivePanel(name string) error {
 
 // GetPanels returns all panel configurations
 func (mcm *MultiConfigManager) GetPanels() []PanelConfig {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+	
 	if mcm.config == nil {
 		return []PanelConfig{}
 	}
-	return mcm.config.Panels
+	return append([]PanelConfig(nil), mcm.config.Panels...)
 }
 
 // GetActivePanelName returns the name of the active panel
 func (mcm *MultiConfigManager) GetActivePanelName() string {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+	
 	if mcm.config == nil {
 		return ""
 	}
@@ -239,17 +298,23 @@ func (mcm *MultiConfigManager) GetActivePanelName() string {
 
 // IsConfigured checks if at least one panel is configured
 func (mcm *MultiConfigManager) IsConfigured() bool {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
 	if mcm.config == nil || len(mcm.config.Panels) == 0 {
 		return false
 	}
 	
-	panel := mcm.GetActivePanel()
+	panel := mcm.activePanel()
 	return panel != nil && panel.PanelURL != "" && panel.APIKey != ""
 }
 
 // UpdateActivePanelServer updates the server ID for the active panel
 func (mcm *MultiConfigManager) UpdateActivePanelServer(serverID string) error {
-	panel := mcm.GetActivePanel()
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
+	panel := mcm.activePanel()
 	if panel == nil {
 		return fmt.Errorf("no active panel")
 	}
@@ -258,13 +323,116 @@ func (mcm *MultiConfigManager) UpdateActivePanelServer(serverID string) error {
 	for i := range mcm.config.Panels {
 		if mcm.config.Panels[i].Name == panel.Name {
 			mcm.config.Panels[i].ServerID = serverID
-			return mcm.Save()
+			return mcm.save()
 		}
 	}
 	
 	return fmt.Errorf("active panel not found in list")
 }
 
+// GetPermissionTemplates returns all saved permission templates
+func (mcm *MultiConfigManager) GetPermissionTemplates() []PermissionTemplate {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+	
+	if mcm.config == nil {
+		return []PermissionTemplate{}
+	}
+	return append([]PermissionTemplate(nil), mcm.config.PermissionTemplates...)
+}
+
+// GetPermissionTemplate returns a permission template by name
+func (mcm *MultiConfigManager) GetPermissionTemplate(name string) *PermissionTemplate {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+	
+	if mcm.config == nil {
+		return nil
+	}
+	
+	for _, t := range mcm.config.PermissionTemplates {
+		if t.Name == name {
+			return &t
+		}
+	}
+	
+	return nil
+}
+
+// AddOrUpdatePermissionTemplate adds or updates a permission template
+func (mcm *MultiConfigManager) AddOrUpdatePermissionTemplate(template PermissionTemplate) error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
+	if mcm.config == nil {
+		mcm.config = &MultiConfig{
+			Panels: []PanelConfig{},
+		}
+	}
+	
+	// Check if template with same name exists
+	for i, t := range mcm.config.PermissionTemplates {
+		if t.Name == template.Name {
+			mcm.config.PermissionTemplates[i] = template
+			return mcm.save()
+		}
+	}
+	
+	mcm.config.PermissionTemplates = append(mcm.config.PermissionTemplates, template)
+	return mcm.save()
+}
+
+// RemovePermissionTemplate removes a permission template
+func (mcm *MultiConfigManager) RemovePermissionTemplate(name string) error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
+	if mcm.config == nil {
+		return nil
+	}
+	
+	var newTemplates []PermissionTemplate
+	for _, t := range mcm.config.PermissionTemplates {
+		if t.Name != name {
+			newTemplates = append(newTemplates, t)
+		}
+	}
+	
+	mcm.config.PermissionTemplates = newTemplates
+	return mcm.save()
+}
+
+// GetConsoleLogConfig returns the console log capture settings
+func (mcm *MultiConfigManager) GetConsoleLogConfig() ConsoleLogConfig {
+	mcm.mu.RLock()
+	defer mcm.mu.RUnlock()
+	
+	if mcm.config == nil || mcm.config.ConsoleLog == nil {
+		return ConsoleLogConfig{}
+	}
+	return *mcm.config.ConsoleLog
+}
+
+// SetConsoleLogConfig updates the console log capture settings
+func (mcm *MultiConfigManager) SetConsoleLogConfig(logConfig ConsoleLogConfig) error {
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	
+	if mcm.config == nil {
+		mcm.config = &MultiConfig{
+			Panels: []PanelConfig{},
+		}
+	}
+	
+	mcm.config.ConsoleLog = &logConfig
+	return mcm.save()
+}
+
+// GetConfigDir returns the directory holding the configuration file
+func (mcm *MultiConfigManager) GetConfigDir() string {
+	return filepath.Dir(mcm.configPath)
+}
+
 // Backward compatibility wrapper
 func (mcm *MultiConfigManager) GetConfig() *Config {
 	panel := mcm.GetActivePanel()
@@ -0,0 +1,468 @@
+package consolelog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rotation selects when the current log file of a server is rotated
+type Rotation string
+
+const (
+	RotateSize  Rotation = "size"  // Rotate once the file reaches MaxSize
+	RotateDaily Rotation = "daily" // Rotate on the first line of a new day
+)
+
+const (
+	// currentName is the file lines are appended to, rotated files are named
+	// console-<timestamp>.log.gz
+	currentName = "console.log"
+	// defaultMaxSize is used for size rotation when no size is configured
+	defaultMaxSize = 10 << 20
+	// timeFormat prefixes every recorded line
+	timeFormat = "2006-01-02T15:04:05.000Z07:00"
+	// maxLineSize is the longest line read back when searching
+	maxLineSize = 1 << 20
+)
+
+// Options configures rotation and retention of the log files
+type Options struct {
+	Rotation Rotation
+	MaxSize  int64 // Bytes, used by size rotation
+	MaxFiles int   // Rotated files kept per server, 0 keeps all
+}
+
+// LogFile represents a captured log file of a server
+type LogFile struct {
+	ServerID   string    `json:"serverId"`
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	ModifiedAt time.Time `json:"modifiedAt"`
+	Compressed bool      `json:"compressed"`
+}
+
+// Match represents a log line matching a search
+type Match struct {
+	ServerID string    `json:"serverId"`
+	File     string    `json:"file"`
+	Line     int       `json:"line"`
+	Time     time.Time `json:"time"`
+	Text     string    `json:"text"`
+}
+
+// Recorder writes console output to rotating per-server log files. Each
+// server gets its own directory below the recorder's directory.
+type Recorder struct {
+	dir      string
+	mu       sync.Mutex
+	opts     Options
+	files    map[string]*logFile // Open current files by server ID
+	closed   bool
+	compress sync.WaitGroup // Running compressions of rotated files
+}
+
+// logFile is the open current log file of a server
+type logFile struct {
+	file *os.File
+	size int64
+	day  string // Day of the last written line, for daily rotation
+}
+
+// NewRecorder creates a recorder writing below dir
+func NewRecorder(dir string, opts Options) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	return &Recorder{
+		dir:   dir,
+		opts:  opts,
+		files: make(map[string]*logFile),
+	}, nil
+}
+
+// SetOptions changes rotation and retention, taking effect with the next line
+func (r *Recorder) SetOptions(opts Options) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.opts = opts
+}
+
+// Write appends console output of a server, one timestamped line per line of text
+func (r *Recorder) Write(serverID, text string) error {
+	dir, err := r.serverDir(serverID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var sb strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(text, "\r\n"), "\n") {
+		sb.WriteString(now.Format(timeFormat))
+		sb.WriteByte(' ')
+		sb.WriteString(strings.TrimSuffix(line, "\r"))
+		sb.WriteByte('\n')
+	}
+	data := sb.String()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return fmt.Errorf("recorder is closed")
+	}
+
+	lf, err := r.open(serverID, dir)
+	if err != nil {
+		return err
+	}
+
+	day := now.Format("2006-01-02")
+	if r.needsRotation(lf, day, int64(len(data))) {
+		if err := r.rotate(serverID, dir, lf); err != nil {
+			return err
+		}
+		if lf, err = r.open(serverID, dir); err != nil {
+			return err
+		}
+	}
+
+	n, err := lf.file.WriteString(data)
+	lf.size += int64(n)
+	lf.day = day
+	if err != nil {
+		return fmt.Errorf("failed to write console log: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes all open log files and waits for running compressions
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	r.closed = true
+	var firstErr error
+	for serverID, lf := range r.files {
+		if err := lf.file.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(r.files, serverID)
+	}
+	r.mu.Unlock()
+
+	r.compress.Wait()
+	return firstErr
+}
+
+// List lists the log files of a server, oldest first. An empty server ID
+// lists the files of all servers.
+func (r *Recorder) List(serverID string) ([]LogFile, error) {
+	serverIDs := []string{serverID}
+	if serverID == "" {
+		entries, err := os.ReadDir(r.dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read log directory: %w", err)
+		}
+		serverIDs = serverIDs[:0]
+		for _, entry := range entries {
+			if entry.IsDir() {
+				serverIDs = append(serverIDs, entry.Name())
+			}
+		}
+	}
+
+	files := []LogFile{}
+	for _, id := range serverIDs {
+		dir, err := r.serverDir(id)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read log directory: %w", err)
+		}
+
+		var serverFiles []LogFile
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !isLogName(name) {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			serverFiles = append(serverFiles, LogFile{
+				ServerID:   id,
+				Name:       name,
+				Size:       info.Size(),
+				ModifiedAt: info.ModTime(),
+				Compressed: strings.HasSuffix(name, ".gz"),
+			})
+		}
+
+		sort.Slice(serverFiles, func(i, j int) bool {
+			return logOrder(serverFiles[i].Name) < logOrder(serverFiles[j].Name)
+		})
+		files = append(files, serverFiles...)
+	}
+
+	return files, nil
+}
+
+// Search returns the most recent lines of a server's logs containing query,
+// ignoring case, oldest first. An empty server ID searches all servers and a
+// limit of 0 returns every match.
+func (r *Recorder) Search(serverID, query string, limit int) ([]Match, error) {
+	if query == "" {
+		return nil, fmt.Errorf("search query is required")
+	}
+
+	files, err := r.List(serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(query)
+	matches := []Match{}
+	for _, file := range files {
+		err := r.scan(file, func(lineNo int, line string) {
+			if !strings.Contains(strings.ToLower(line), query) {
+				return
+			}
+
+			match := Match{ServerID: file.ServerID, File: file.Name, Line: lineNo, Text: line}
+			if stamp, text, ok := strings.Cut(line, " "); ok {
+				if t, err := time.Parse(timeFormat, stamp); err == nil {
+					match.Time = t
+					match.Text = text
+				}
+			}
+
+			matches = append(matches, match)
+			if limit > 0 && len(matches) > limit {
+				matches = matches[1:]
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return matches, nil
+}
+
+// scan calls fn for every line of a log file
+func (r *Recorder) scan(file LogFile, fn func(lineNo int, line string)) error {
+	f, err := os.Open(filepath.Join(r.dir, file.ServerID, file.Name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // Rotated or pruned while searching
+		}
+		return fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer f.Close()
+
+	var reader io.Reader = f
+	if file.Compressed {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		fn(lineNo, scanner.Text())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+
+	return nil
+}
+
+// serverDir returns the log directory of a server
+func (r *Recorder) serverDir(serverID string) (string, error) {
+	if serverID == "" || serverID == "." || serverID == ".." || strings.ContainsAny(serverID, `/\`) {
+		return "", fmt.Errorf("invalid server ID %q", serverID)
+	}
+	return filepath.Join(r.dir, serverID), nil
+}
+
+// open returns the current log file of a server, opening it if needed
+func (r *Recorder) open(serverID, dir string) (*logFile, error) {
+	if lf, ok := r.files[serverID]; ok {
+		return lf, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, currentName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open console log: %w", err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to open console log: %w", err)
+	}
+
+	lf := &logFile{file: f, size: info.Size()}
+	if info.Size() > 0 {
+		// Continue an existing file, rotating it if it is from an earlier day
+		lf.day = info.ModTime().Format("2006-01-02")
+	}
+
+	r.files[serverID] = lf
+	return lf, nil
+}
+
+// needsRotation reports whether the file must be rotated before writing n bytes
+func (r *Recorder) needsRotation(lf *logFile, day string, n int64) bool {
+	if lf.size == 0 {
+		return false
+	}
+
+	switch r.opts.Rotation {
+	case RotateDaily:
+		return lf.day != "" && lf.day != day
+	default:
+		maxSize := r.opts.MaxSize
+		if maxSize <= 0 {
+			maxSize = defaultMaxSize
+		}
+		return lf.size+n > maxSize
+	}
+}
+
+// rotate closes the current file of a server, renames it and compresses it in the background
+func (r *Recorder) rotate(serverID, dir string, lf *logFile) error {
+	lf.file.Close()
+	delete(r.files, serverID)
+
+	stamp := time.Now().Format("20060102-150405")
+	rotated := filepath.Join(dir, "console-"+stamp+".log")
+	for i := 1; fileExists(rotated) || fileExists(rotated+".gz"); i++ {
+		rotated = filepath.Join(dir, fmt.Sprintf("console-%s.%d.log", stamp, i))
+	}
+
+	if err := os.Rename(filepath.Join(dir, currentName), rotated); err != nil {
+		return fmt.Errorf("failed to rotate console log: %w", err)
+	}
+
+	maxFiles := r.opts.MaxFiles
+	r.compress.Add(1)
+	go func() {
+		defer r.compress.Done()
+		compressFile(rotated)
+		pruneFiles(dir, maxFiles)
+	}()
+
+	return nil
+}
+
+// compressFile gzips a rotated file and removes the original. On failure the
+// uncompressed file is kept.
+func compressFile(path string) {
+	src, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer src.Close()
+
+	tmp := path + ".gz.tmp"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return
+	}
+
+	gz := gzip.NewWriter(dst)
+	_, err = io.Copy(gz, src)
+	if closeErr := gz.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path+".gz")
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return
+	}
+
+	src.Close()
+	os.Remove(path)
+}
+
+// pruneFiles removes the oldest rotated files of a server beyond maxFiles
+func pruneFiles(dir string, maxFiles int) {
+	if maxFiles <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	var rotated []string
+	for _, entry := range entries {
+		if name := entry.Name(); isLogName(name) && name != currentName {
+			rotated = append(rotated, name)
+		}
+	}
+	sort.Slice(rotated, func(i, j int) bool { return logOrder(rotated[i]) < logOrder(rotated[j]) })
+
+	for len(rotated) > maxFiles {
+		os.Remove(filepath.Join(dir, rotated[0]))
+		rotated = rotated[1:]
+	}
+}
+
+// isLogName reports whether name is a current or rotated log file
+func isLogName(name string) bool {
+	return name == currentName ||
+		strings.HasPrefix(name, "console-") && (strings.HasSuffix(name, ".log") || strings.HasSuffix(name, ".log.gz"))
+}
+
+// logOrder returns a sort key ordering log files oldest first, with the current file last
+func logOrder(name string) string {
+	if name == currentName {
+		return "~"
+	}
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".log")
+
+	// Pad the counter of same-second rotations so they sort numerically
+	stamp, counter, _ := strings.Cut(strings.TrimPrefix(name, "console-"), ".")
+	return fmt.Sprintf("%s.%08s", stamp, counter)
+}
+
+// fileExists reports whether a file exists at path
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
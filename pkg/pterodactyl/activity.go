@@ -0,0 +1,138 @@
+package pterodactyl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ActivityActor represents the user that caused an activity event
+type ActivityActor struct {
+	UUID     string `json:"uuid"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// ActivityEvent represents a single entry of a server's activity log
+type ActivityEvent struct {
+	ID          string                 `json:"id"`
+	Batch       string                 `json:"batch,omitempty"`
+	Event       string                 `json:"event"` // e.g. "server:file.delete" or "server:power.restart"
+	IsAPI       bool                   `json:"is_api"`
+	IP          string                 `json:"ip"`
+	Description string                 `json:"description,omitempty"`
+	Properties  map[string]interface{} `json:"properties"`
+	Timestamp   time.Time              `json:"timestamp"`
+	Actor       *ActivityActor         `json:"actor,omitempty"` // Nil for system events
+}
+
+// ActivityObject represents an activity log object in the API response
+type ActivityObject struct {
+	Object     string `json:"object"`
+	Attributes struct {
+		ID            string          `json:"id"`
+		Batch         string          `json:"batch"`
+		Event         string          `json:"event"`
+		IsAPI         bool            `json:"is_api"`
+		IP            string          `json:"ip"`
+		Description   string          `json:"description"`
+		Properties    json.RawMessage `json:"properties"`
+		Timestamp     time.Time       `json:"timestamp"`
+		Relationships struct {
+			Actor *struct {
+				Attributes ActivityActor `json:"attributes"`
+			} `json:"actor"`
+		} `json:"relationships"`
+	} `json:"attributes"`
+}
+
+// ListActivityResponse represents the response from the activity endpoint
+type ListActivityResponse struct {
+	Object string           `json:"object"`
+	Data   []ActivityObject `json:"data"`
+	Meta   struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// ActivityPage represents one page of activity events
+type ActivityPage struct {
+	Events     []ActivityEvent
+	Pagination Pagination
+}
+
+// toEvent converts an activity object to an ActivityEvent
+func (ao ActivityObject) toEvent() ActivityEvent {
+	attrs := ao.Attributes
+	event := ActivityEvent{
+		ID:          attrs.ID,
+		Batch:       attrs.Batch,
+		Event:       attrs.Event,
+		IsAPI:       attrs.IsAPI,
+		IP:          attrs.IP,
+		Description: attrs.Description,
+		Timestamp:   attrs.Timestamp,
+		Properties:  map[string]interface{}{},
+	}
+
+	// The panel sends an empty array instead of an object when there are no properties
+	_ = json.Unmarshal(attrs.Properties, &event.Properties)
+	if event.Properties == nil {
+		event.Properties = map[string]interface{}{}
+	}
+
+	if attrs.Relationships.Actor != nil {
+		actor := attrs.Relationships.Actor.Attributes
+		event.Actor = &actor
+	}
+
+	return event
+}
+
+// ListActivity lists one page of the server's activity log, newest first
+// event optionally filters by event name (partial match), e.g. "server:file"
+func (c *Client) ListActivity(page, perPage int, event string) (*ActivityPage, error) {
+	return c.ListActivityCtx(context.Background(), page, perPage, event)
+}
+
+// ListActivityCtx is like ListActivity but cancelling ctx aborts the request
+func (c *Client) ListActivityCtx(ctx context.Context, page, perPage int, event string) (*ActivityPage, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/activity", c.baseURL, c.serverID)
+
+	req := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("page", strconv.Itoa(page)).
+		SetQueryParam("per_page", strconv.Itoa(perPage)).
+		SetQueryParam("sort", "-timestamp").
+		SetQueryParam("include", "actor").
+		SetResult(&ListActivityResponse{})
+
+	if event != "" {
+		req.SetQueryParam("filter[event]", event)
+	}
+
+	resp, err := req.Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activity: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListActivityResponse)
+
+	activity := &ActivityPage{
+		Events:     make([]ActivityEvent, len(result.Data)),
+		Pagination: result.Meta.Pagination,
+	}
+	for i, obj := range result.Data {
+		activity.Events[i] = obj.toEvent()
+	}
+
+	return activity, nil
+}
@@ -0,0 +1,180 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+// Allocation represents a network allocation (ip:port) assigned to a server
+type Allocation struct {
+	ID        int    `json:"id"`
+	IP        string `json:"ip"`
+	IPAlias   string `json:"ip_alias"`
+	Port      int    `json:"port"`
+	Notes     string `json:"notes"`
+	IsDefault bool   `json:"is_default"`
+}
+
+// AllocationObject represents an allocation object in the API response
+type AllocationObject struct {
+	Object     string     `json:"object"`
+	Attributes Allocation `json:"attributes"`
+}
+
+// ListAllocationsResponse represents the response from the list allocations endpoint
+type ListAllocationsResponse struct {
+	Object string             `json:"object"`
+	Data   []AllocationObject `json:"data"`
+}
+
+// Address returns the connect address of the allocation, preferring the IP alias
+func (al Allocation) Address() string {
+	host := al.IP
+	if al.IPAlias != "" {
+		host = al.IPAlias
+	}
+	return net.JoinHostPort(host, strconv.Itoa(al.Port))
+}
+
+// ListAllocations lists all allocations assigned to the server
+func (c *Client) ListAllocations() ([]Allocation, error) {
+	return c.ListAllocationsCtx(context.Background())
+}
+
+// ListAllocationsCtx is like ListAllocations but cancelling ctx aborts the request
+func (c *Client) ListAllocationsCtx(ctx context.Context) ([]Allocation, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/network/allocations", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ListAllocationsResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list allocations: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListAllocationsResponse)
+
+	allocations := make([]Allocation, len(result.Data))
+	for i, obj := range result.Data {
+		allocations[i] = obj.Attributes
+	}
+
+	return allocations, nil
+}
+
+// AssignAllocation automatically assigns a new allocation to the server
+func (c *Client) AssignAllocation() (*Allocation, error) {
+	return c.AssignAllocationCtx(context.Background())
+}
+
+// AssignAllocationCtx is like AssignAllocation but cancelling ctx aborts the request
+func (c *Client) AssignAllocationCtx(ctx context.Context) (*Allocation, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/network/allocations", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&AllocationObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign allocation: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*AllocationObject)
+	return &result.Attributes, nil
+}
+
+// SetPrimaryAllocation makes an allocation the server's primary allocation
+func (c *Client) SetPrimaryAllocation(allocationID int) (*Allocation, error) {
+	return c.SetPrimaryAllocationCtx(context.Background(), allocationID)
+}
+
+// SetPrimaryAllocationCtx is like SetPrimaryAllocation but cancelling ctx aborts the request
+func (c *Client) SetPrimaryAllocationCtx(ctx context.Context, allocationID int) (*Allocation, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/network/allocations/%d/primary", c.baseURL, c.serverID, allocationID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&AllocationObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to set primary allocation: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*AllocationObject)
+	return &result.Attributes, nil
+}
+
+// SetAllocationNotes updates the notes of an allocation
+func (c *Client) SetAllocationNotes(allocationID int, notes string) (*Allocation, error) {
+	return c.SetAllocationNotesCtx(context.Background(), allocationID, notes)
+}
+
+// SetAllocationNotesCtx is like SetAllocationNotes but cancelling ctx aborts the request
+func (c *Client) SetAllocationNotesCtx(ctx context.Context, allocationID int, notes string) (*Allocation, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/network/allocations/%d", c.baseURL, c.serverID, allocationID)
+
+	body := map[string]string{
+		"notes": notes,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		SetResult(&AllocationObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update allocation notes: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*AllocationObject)
+	return &result.Attributes, nil
+}
+
+// UnassignAllocation removes an allocation from the server
+// The primary allocation cannot be removed
+func (c *Client) UnassignAllocation(allocationID int) error {
+	return c.UnassignAllocationCtx(context.Background(), allocationID)
+}
+
+// UnassignAllocationCtx is like UnassignAllocation but cancelling ctx aborts the request
+func (c *Client) UnassignAllocationCtx(ctx context.Context, allocationID int) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/network/allocations/%d", c.baseURL, c.serverID, allocationID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Delete(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to unassign allocation: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
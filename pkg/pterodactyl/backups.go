@@ -0,0 +1,220 @@
+package pterodactyl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Backup represents a server backup
+type Backup struct {
+	UUID         string     `json:"uuid"`
+	Name         string     `json:"name"`
+	IgnoredFiles []string   `json:"ignored_files"`
+	Checksum     string     `json:"checksum"`
+	Bytes        int64      `json:"bytes"`
+	IsSuccessful bool       `json:"is_successful"`
+	IsLocked     bool       `json:"is_locked"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CompletedAt  *time.Time `json:"completed_at"`
+}
+
+// BackupObject represents a backup object in the API response
+type BackupObject struct {
+	Object     string `json:"object"`
+	Attributes Backup `json:"attributes"`
+}
+
+// ListBackupsResponse represents the response from the list backups endpoint
+type ListBackupsResponse struct {
+	Object string         `json:"object"`
+	Data   []BackupObject `json:"data"`
+}
+
+// CreateBackupRequest represents a backup creation request
+type CreateBackupRequest struct {
+	Name     string `json:"name,omitempty"`
+	Ignored  string `json:"ignored,omitempty"` // Newline separated list of ignored paths
+	IsLocked bool   `json:"is_locked"`
+}
+
+// ListBackups lists all backups of the server
+func (c *Client) ListBackups() ([]Backup, error) {
+	return c.ListBackupsCtx(context.Background())
+}
+
+// ListBackupsCtx is like ListBackups but cancelling ctx aborts the request
+func (c *Client) ListBackupsCtx(ctx context.Context) ([]Backup, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/backups", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ListBackupsResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListBackupsResponse)
+
+	backups := make([]Backup, len(result.Data))
+	for i, obj := range result.Data {
+		backups[i] = obj.Attributes
+	}
+
+	return backups, nil
+}
+
+// CreateBackup starts a new backup of the server
+func (c *Client) CreateBackup(name string, ignored []string, locked bool) (*Backup, error) {
+	return c.CreateBackupCtx(context.Background(), name, ignored, locked)
+}
+
+// CreateBackupCtx is like CreateBackup but cancelling ctx aborts the request
+func (c *Client) CreateBackupCtx(ctx context.Context, name string, ignored []string, locked bool) (*Backup, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/backups", c.baseURL, c.serverID)
+
+	req := CreateBackupRequest{
+		Name:     name,
+		Ignored:  strings.Join(ignored, "\n"),
+		IsLocked: locked,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&BackupObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backup: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*BackupObject)
+	return &result.Attributes, nil
+}
+
+// DeleteBackup deletes a backup
+func (c *Client) DeleteBackup(backupUUID string) error {
+	return c.DeleteBackupCtx(context.Background(), backupUUID)
+}
+
+// DeleteBackupCtx is like DeleteBackup but cancelling ctx aborts the request
+func (c *Client) DeleteBackupCtx(ctx context.Context, backupUUID string) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/backups/%s", c.baseURL, c.serverID, backupUUID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Delete(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete backup: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// RestoreBackup restores a backup, optionally deleting all server files first
+func (c *Client) RestoreBackup(backupUUID string, truncate bool) error {
+	return c.RestoreBackupCtx(context.Background(), backupUUID, truncate)
+}
+
+// RestoreBackupCtx is like RestoreBackup but cancelling ctx aborts the request
+func (c *Client) RestoreBackupCtx(ctx context.Context, backupUUID string, truncate bool) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/backups/%s/restore", c.baseURL, c.serverID, backupUUID)
+
+	payload := map[string]bool{
+		"truncate": truncate,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(payload).
+		Post(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to restore backup: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// ToggleBackupLock locks or unlocks a backup and returns its new state
+func (c *Client) ToggleBackupLock(backupUUID string) (*Backup, error) {
+	return c.ToggleBackupLockCtx(context.Background(), backupUUID)
+}
+
+// ToggleBackupLockCtx is like ToggleBackupLock but cancelling ctx aborts the request
+func (c *Client) ToggleBackupLockCtx(ctx context.Context, backupUUID string) (*Backup, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/backups/%s/lock", c.baseURL, c.serverID, backupUUID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&BackupObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to toggle backup lock: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*BackupObject)
+	return &result.Attributes, nil
+}
+
+// GetBackupDownloadURL gets a signed download URL for a backup
+func (c *Client) GetBackupDownloadURL(backupUUID string) (string, error) {
+	return c.GetBackupDownloadURLCtx(context.Background(), backupUUID)
+}
+
+// GetBackupDownloadURLCtx is like GetBackupDownloadURL but cancelling ctx aborts the request
+func (c *Client) GetBackupDownloadURLCtx(ctx context.Context, backupUUID string) (string, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/backups/%s/download", c.baseURL, c.serverID, backupUUID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Get(endpoint)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get backup download URL: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return "", newAPIError(resp)
+	}
+
+	var result struct {
+		Attributes struct {
+			URL string `json:"url"`
+		} `json:"attributes"`
+	}
+
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return result.Attributes.URL, nil
+}
@@ -1,11 +1,14 @@
 package pterodactyl
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +22,8 @@ type Client struct {
 	apiKey    string
 	serverID  string
 	isAdmin   bool // Track if this is an admin API key
+	transfer  *http.Client // Client without an overall timeout for streaming file transfers
+	limiter   *rateLimiter // Request pacing, shared with clients derived through ForServer
 }
 
 // FileAttributes represents the attributes of a file or directory
@@ -83,6 +88,38 @@ type DeleteRequest struct {
 	Files []string `json:"files"`
 }
 
+// CompressRequest represents a file compression request
+type CompressRequest struct {
+	Root  string   `json:"root"`
+	Files []string `json:"files"`
+}
+
+// DecompressRequest represents an archive extraction request
+type DecompressRequest struct {
+	Root string `json:"root"`
+	File string `json:"file"`
+}
+
+// ChmodRequest represents a file permission change request
+type ChmodRequest struct {
+	Root  string      `json:"root"`
+	Files []ChmodFile `json:"files"`
+}
+
+// ChmodFile represents a single file permission change
+type ChmodFile struct {
+	File string `json:"file"`
+	Mode string `json:"mode"` // Octal mode, e.g. "644"
+}
+
+// PullRequest represents a request to download a remote URL onto the server
+type PullRequest struct {
+	URL       string `json:"url"`
+	Directory string `json:"directory,omitempty"`
+	Filename  string `json:"filename,omitempty"`
+	UseHeader bool   `json:"use_header"` // Use the filename from the Content-Disposition header
+}
+
 // ServerAttributes represents server attributes from the API
 type ServerAttributes struct {
 	UUID        string `json:"uuid"`
@@ -91,6 +128,24 @@ type ServerAttributes struct {
 	Description string `json:"description"`
 	IsOwner     bool   `json:"is_owner"`
 	Status      string `json:"status,omitempty"`
+	Node        string `json:"node"`
+	IsNodeUnderMaintenance bool `json:"is_node_under_maintenance"`
+	SFTPDetails   SFTPDetails   `json:"sftp_details"`
+	Limits        ServerLimits  `json:"limits"`
+	FeatureLimits FeatureLimits `json:"feature_limits"`
+	DockerImage   string        `json:"docker_image"`
+	Invocation    string        `json:"invocation"`
+	IsSuspended   bool          `json:"is_suspended"`
+	IsInstalling  bool          `json:"is_installing"`
+	IsTransferring bool         `json:"is_transferring"`
+	Relationships struct {
+		Allocations struct {
+			Data []AllocationObject `json:"data"`
+		} `json:"allocations"`
+		Egg struct {
+			Attributes Egg `json:"attributes"`
+		} `json:"egg"` // Only present with include=egg
+	} `json:"relationships"`
 }
 
 // ServerObject represents a server object in the API response
@@ -103,6 +158,18 @@ type ServerObject struct {
 type ListServersResponse struct {
 	Object string         `json:"object"`
 	Data   []ServerObject `json:"data"`
+	Meta   struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// Pagination represents the pagination block in the meta of list responses
+type Pagination struct {
+	Total       int `json:"total"`
+	Count       int `json:"count"`
+	PerPage     int `json:"per_page"`
+	CurrentPage int `json:"current_page"`
+	TotalPages  int `json:"total_pages"`
 }
 
 // ServerInfo represents simplified server information
@@ -115,6 +182,13 @@ type ServerInfo struct {
 	Description string `json:"description"`
 	IsOwner     bool   `json:"is_owner"`
 	Status      string `json:"status,omitempty"`
+	PrimaryAllocation string `json:"primary_allocation,omitempty"` // Connect address (ip:port) of the primary allocation
+	Node          string        `json:"node,omitempty"`
+	NodeID        int           `json:"node_id,omitempty"` // Only set by the application API
+	Egg           string        `json:"egg,omitempty"` // Egg name
+	SFTP          SFTPDetails   `json:"sftp"`
+	Limits        ServerLimits  `json:"limits"`
+	FeatureLimits FeatureLimits `json:"feature_limits"`
 }
 
 // NewClient creates a new Pterodactyl API client
@@ -125,15 +199,23 @@ func NewClient(baseURL, apiKey, serverID string) *Client {
 	client.SetHeader("Accept", "application/json")
 	client.SetHeader("Content-Type", "application/json")
 
+	// File transfers can run far longer than the API timeout, only bound the wait for headers
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = 30 * time.Second
+
 	c := &Client{
 		client:   client,
+		transfer: &http.Client{Transport: transport},
 		baseURL:  strings.TrimSuffix(baseURL, "/"),
 		apiKey:   apiKey,
 		serverID: serverID,
+		limiter:  &rateLimiter{},
 	}
 	
-	// Auto-detect if this is an admin API key
+	// Auto-detect if this is an admin API key. This runs before the retry
+	// policy is installed so an unreachable panel fails after one attempt.
 	c.detectAPIType()
+	c.setupRetries()
 	
 	return c
 }
@@ -160,12 +242,24 @@ func (c *Client) SetServerID(serverID string) {
 	c.serverID = serverID
 }
 
+// ForServer returns a client for another server of the same panel. It shares
+// the connections and API type of c, so it is cheap to create per call and
+// safe to use concurrently with c.
+func (c *Client) ForServer(serverID string) *Client {
+	clone := *c
+	clone.serverID = serverID
+	return &clone
+}
+
 // Close closes all HTTP connections
 func (c *Client) Close() {
 	// This forces the resty client to close all connections
 	if c.client != nil {
 		c.client.GetClient().CloseIdleConnections()
 	}
+	if c.transfer != nil {
+		c.transfer.CloseIdleConnections()
+	}
 }
 
 // GetServerID returns the current server ID
@@ -183,28 +277,50 @@ func (c *Client) IsAdmin() bool {
 	return c.isAdmin
 }
 
-// ListServers lists all servers the user has access to
-func (c *Client) ListServers() ([]ServerInfo, error) {
-	if c.isAdmin {
-		return c.listServersAdmin()
+// requireAdmin returns an error unless the client uses an application API key
+func (c *Client) requireAdmin() error {
+	if !c.isAdmin {
+		return fmt.Errorf("an application (admin) API key is required")
 	}
-	return c.listServersClient()
+	return nil
+}
+
+// ListServers lists all servers the user has access to, following pagination
+func (c *Client) ListServers() ([]ServerInfo, error) {
+	return c.ListServersCtx(context.Background())
 }
 
-// listServersClient lists servers using client API
-func (c *Client) listServersClient() ([]ServerInfo, error) {
+// ListServersCtx is like ListServers but cancelling ctx aborts the requests
+func (c *Client) ListServersCtx(ctx context.Context) ([]ServerInfo, error) {
+	return c.IterateServersCtx(ctx, ServerFilter{}).All()
+}
+
+// listServersClient lists one page of servers using client API
+func (c *Client) listServersClient(ctx context.Context, filter ServerFilter, page int) ([]ServerInfo, Pagination, error) {
 	endpoint := fmt.Sprintf("%s/api/client", c.baseURL)
 	
-	resp, err := c.client.R().
-		SetResult(&ListServersResponse{}).
-		Get(endpoint)
+	req := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("include", "allocations,egg").
+		SetQueryParam("page", strconv.Itoa(page)).
+		SetQueryParam("per_page", strconv.Itoa(clientPerPage)).
+		SetResult(&ListServersResponse{})
+	
+	if filter.Name != "" {
+		req.SetQueryParam("filter[name]", filter.Name)
+	}
+	if filter.Type != "" {
+		req.SetQueryParam("type", filter.Type)
+	}
+	
+	resp, err := req.Get(endpoint)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to list servers: %w", err)
+		return nil, Pagination{}, fmt.Errorf("failed to list servers: %w", err)
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return nil, Pagination{}, newAPIError(resp)
 	}
 
 	result := resp.Result().(*ListServersResponse)
@@ -219,27 +335,47 @@ func (c *Client) listServersClient() ([]ServerInfo, error) {
 			Description: obj.Attributes.Description,
 			IsOwner:     obj.Attributes.IsOwner,
 			Status:      obj.Attributes.Status,
+			Node:          obj.Attributes.Node,
+			Egg:           obj.Attributes.Relationships.Egg.Attributes.Name,
+			SFTP:          obj.Attributes.SFTPDetails,
+			Limits:        obj.Attributes.Limits,
+			FeatureLimits: obj.Attributes.FeatureLimits,
+		}
+		
+		// Client API includes allocations by default
+		for _, alloc := range obj.Attributes.Relationships.Allocations.Data {
+			if alloc.Attributes.IsDefault {
+				servers[i].PrimaryAllocation = alloc.Attributes.Address()
+				break
+			}
 		}
 	}
 	
-	return servers, nil
+	return servers, result.Meta.Pagination, nil
 }
 
-// listServersAdmin lists servers using admin API
-func (c *Client) listServersAdmin() ([]ServerInfo, error) {
+// listServersAdmin lists one page of servers using admin API
+func (c *Client) listServersAdmin(ctx context.Context, filter ServerFilter, page int) ([]ServerInfo, Pagination, error) {
 	endpoint := fmt.Sprintf("%s/api/application/servers", c.baseURL)
 	
-	// Admin API requires pagination parameters
-	resp, err := c.client.R().
-		SetQueryParam("per_page", "100").
-		Get(endpoint)
+	req := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("page", strconv.Itoa(page)).
+		SetQueryParam("per_page", strconv.Itoa(applicationPerPage)).
+		SetQueryParam("include", "allocations,node,egg")
+	
+	if filter.Name != "" {
+		req.SetQueryParam("filter[name]", filter.Name)
+	}
+	
+	resp, err := req.Get(endpoint)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to list servers: %w", err)
+		return nil, Pagination{}, fmt.Errorf("failed to list servers: %w", err)
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return nil, Pagination{}, newAPIError(resp)
 	}
 
 	// Parse admin API response
@@ -250,12 +386,41 @@ func (c *Client) listServersAdmin() ([]ServerInfo, error) {
 				UUID        string `json:"uuid"`
 				Name        string `json:"name"`
 				Description string `json:"description"`
+				Allocation  int    `json:"allocation"` // ID of the primary allocation
+				Node        int    `json:"node"`       // ID of the node
+				Limits        ServerLimits  `json:"limits"`
+				FeatureLimits FeatureLimits `json:"feature_limits"`
+				Relationships struct {
+					Allocations struct {
+						Data []struct {
+							Attributes struct {
+								ID    int    `json:"id"`
+								IP    string `json:"ip"`
+								Alias string `json:"alias"`
+								Port  int    `json:"port"`
+							} `json:"attributes"`
+						} `json:"data"`
+					} `json:"allocations"`
+					Node struct {
+						Attributes struct {
+							Name       string `json:"name"`
+							FQDN       string `json:"fqdn"`
+							DaemonSFTP int    `json:"daemon_sftp"`
+						} `json:"attributes"`
+					} `json:"node"`
+					Egg struct {
+						Attributes Egg `json:"attributes"`
+					} `json:"egg"`
+				} `json:"relationships"`
 			} `json:"attributes"`
 		} `json:"data"`
+		Meta struct {
+			Pagination Pagination `json:"pagination"`
+		} `json:"meta"`
 	}
 	
 	if err := json.Unmarshal(resp.Body(), &adminResp); err != nil {
-		return nil, fmt.Errorf("failed to parse admin response: %w", err)
+		return nil, Pagination{}, fmt.Errorf("failed to parse admin response: %w", err)
 	}
 	
 	// Convert to ServerInfo
@@ -267,19 +432,62 @@ func (c *Client) listServersAdmin() ([]ServerInfo, error) {
 			Name:        obj.Attributes.Name,
 			Description: obj.Attributes.Description,
 			IsOwner:     true, // Admins own all servers
+			Node:          obj.Attributes.Relationships.Node.Attributes.Name,
+			NodeID:        obj.Attributes.Node,
+			Egg:           obj.Attributes.Relationships.Egg.Attributes.Name,
+			Limits:        obj.Attributes.Limits,
+			FeatureLimits: obj.Attributes.FeatureLimits,
+		}
+		
+		// The application API has no SFTP details, they are served by the node
+		if node := obj.Attributes.Relationships.Node.Attributes; node.FQDN != "" {
+			servers[i].SFTP = SFTPDetails{IP: node.FQDN, Port: node.DaemonSFTP}
+		}
+		
+		for _, alloc := range obj.Attributes.Relationships.Allocations.Data {
+			if alloc.Attributes.ID == obj.Attributes.Allocation {
+				primary := Allocation{
+					IP:      alloc.Attributes.IP,
+					IPAlias: alloc.Attributes.Alias,
+					Port:    alloc.Attributes.Port,
+				}
+				servers[i].PrimaryAllocation = primary.Address()
+				break
+			}
 		}
 	}
 	
-	return servers, nil
+	return servers, adminResp.Meta.Pagination, nil
+}
+
+// toFileInfo converts file attributes to a FileInfo
+func (fa FileAttributes) toFileInfo() FileInfo {
+	return FileInfo{
+		Name:       fa.Name,
+		Mode:       fa.Mode,
+		ModeBits:   fa.ModeBits,
+		Size:       fa.Size,
+		IsFile:     fa.IsFile,
+		IsSymlink:  fa.IsSymlink,
+		MimeType:   fa.MimeType,
+		CreatedAt:  fa.CreatedAt,
+		ModifiedAt: fa.ModifiedAt,
+	}
 }
 
 // ListFiles lists files in a directory
 func (c *Client) ListFiles(path string) ([]FileInfo, error) {
+	return c.ListFilesCtx(context.Background(), path)
+}
+
+// ListFilesCtx is like ListFiles but cancelling ctx aborts the request
+func (c *Client) ListFilesCtx(ctx context.Context, path string) ([]FileInfo, error) {
 	// Note: Admin API keys can also access client endpoints
 	encodedPath := url.QueryEscape(path)
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/list?directory=%s", c.baseURL, c.serverID, encodedPath)
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetResult(&ListFilesResponse{}).
 		Get(endpoint)
 
@@ -288,7 +496,7 @@ func (c *Client) ListFiles(path string) ([]FileInfo, error) {
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError(resp)
 	}
 
 	result := resp.Result().(*ListFilesResponse)
@@ -296,17 +504,7 @@ func (c *Client) ListFiles(path string) ([]FileInfo, error) {
 	// Convert FileObjects to FileInfo
 	files := make([]FileInfo, len(result.Data))
 	for i, obj := range result.Data {
-		files[i] = FileInfo{
-			Name:       obj.Attributes.Name,
-			Mode:       obj.Attributes.Mode,
-			ModeBits:   obj.Attributes.ModeBits,
-			Size:       obj.Attributes.Size,
-			IsFile:     obj.Attributes.IsFile,
-			IsSymlink:  obj.Attributes.IsSymlink,
-			MimeType:   obj.Attributes.MimeType,
-			CreatedAt:  obj.Attributes.CreatedAt,
-			ModifiedAt: obj.Attributes.ModifiedAt,
-		}
+		files[i] = obj.Attributes.toFileInfo()
 	}
 	
 	return files, nil
@@ -314,11 +512,17 @@ func (c *Client) ListFiles(path string) ([]FileInfo, error) {
 
 // GetFileContent retrieves the content of a file
 func (c *Client) GetFileContent(path string) (string, error) {
+	return c.GetFileContentCtx(context.Background(), path)
+}
+
+// GetFileContentCtx is like GetFileContent but cancelling ctx aborts the request
+func (c *Client) GetFileContentCtx(ctx context.Context, path string) (string, error) {
 	// Note: Admin API keys can also access client endpoints
 	encodedPath := url.QueryEscape(path)
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/contents?file=%s", c.baseURL, c.serverID, encodedPath)
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		Get(endpoint)
 
 	if err != nil {
@@ -326,7 +530,7 @@ func (c *Client) GetFileContent(path string) (string, error) {
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return "", fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return "", newAPIError(resp)
 	}
 
 	return string(resp.Body()), nil
@@ -334,10 +538,16 @@ func (c *Client) GetFileContent(path string) (string, error) {
 
 // SaveFileContent saves content to a file
 func (c *Client) SaveFileContent(path, content string) error {
+	return c.SaveFileContentCtx(context.Background(), path, content)
+}
+
+// SaveFileContentCtx is like SaveFileContent but cancelling ctx aborts the request
+func (c *Client) SaveFileContentCtx(ctx context.Context, path, content string) error {
 	encodedPath := url.QueryEscape(path)
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/write?file=%s", c.baseURL, c.serverID, encodedPath)
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetBody(content).
 		SetHeader("Content-Type", "text/plain").
 		Post(endpoint)
@@ -347,7 +557,7 @@ func (c *Client) SaveFileContent(path, content string) error {
 	}
 
 	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return newAPIError(resp)
 	}
 
 	return nil
@@ -355,6 +565,11 @@ func (c *Client) SaveFileContent(path, content string) error {
 
 // CreateDirectory creates a new directory
 func (c *Client) CreateDirectory(path, name string) error {
+	return c.CreateDirectoryCtx(context.Background(), path, name)
+}
+
+// CreateDirectoryCtx is like CreateDirectory but cancelling ctx aborts the request
+func (c *Client) CreateDirectoryCtx(ctx context.Context, path, name string) error {
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/create-folder", c.baseURL, c.serverID)
 	
 	body := map[string]string{
@@ -363,6 +578,7 @@ func (c *Client) CreateDirectory(path, name string) error {
 	}
 
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetBody(body).
 		Post(endpoint)
 
@@ -371,7 +587,7 @@ func (c *Client) CreateDirectory(path, name string) error {
 	}
 
 	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return newAPIError(resp)
 	}
 
 	return nil
@@ -379,6 +595,11 @@ func (c *Client) CreateDirectory(path, name string) error {
 
 // RenameFile renames a file or directory
 func (c *Client) RenameFile(root, oldName, newName string) error {
+	return c.RenameFileCtx(context.Background(), root, oldName, newName)
+}
+
+// RenameFileCtx is like RenameFile but cancelling ctx aborts the request
+func (c *Client) RenameFileCtx(ctx context.Context, root, oldName, newName string) error {
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/rename", c.baseURL, c.serverID)
 	
 	req := RenameRequest{
@@ -392,6 +613,7 @@ func (c *Client) RenameFile(root, oldName, newName string) error {
 	}
 
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetBody(req).
 		Put(endpoint)
 
@@ -400,7 +622,7 @@ func (c *Client) RenameFile(root, oldName, newName string) error {
 	}
 
 	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return newAPIError(resp)
 	}
 
 	return nil
@@ -408,6 +630,11 @@ func (c *Client) RenameFile(root, oldName, newName string) error {
 
 // DeleteFiles deletes one or more files
 func (c *Client) DeleteFiles(root string, files []string) error {
+	return c.DeleteFilesCtx(context.Background(), root, files)
+}
+
+// DeleteFilesCtx is like DeleteFiles but cancelling ctx aborts the request
+func (c *Client) DeleteFilesCtx(ctx context.Context, root string, files []string) error {
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/delete", c.baseURL, c.serverID)
 	
 	req := DeleteRequest{
@@ -416,6 +643,7 @@ func (c *Client) DeleteFiles(root string, files []string) error {
 	}
 
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetBody(req).
 		Post(endpoint)
 
@@ -424,18 +652,171 @@ func (c *Client) DeleteFiles(root string, files []string) error {
 	}
 
 	if resp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// CompressFiles compresses files of a directory into a new archive and returns the archive
+func (c *Client) CompressFiles(root string, files []string) (*FileInfo, error) {
+	return c.CompressFilesCtx(context.Background(), root, files)
+}
+
+// CompressFilesCtx is like CompressFiles but cancelling ctx aborts the request
+func (c *Client) CompressFilesCtx(ctx context.Context, root string, files []string) (*FileInfo, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/compress", c.baseURL, c.serverID)
+	
+	req := CompressRequest{
+		Root:  root,
+		Files: files,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&FileObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress files: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	archive := resp.Result().(*FileObject).Attributes.toFileInfo()
+	return &archive, nil
+}
+
+// DecompressFile extracts an archive into the directory it is in
+func (c *Client) DecompressFile(root, file string) error {
+	return c.DecompressFileCtx(context.Background(), root, file)
+}
+
+// DecompressFileCtx is like DecompressFile but cancelling ctx aborts the request
+func (c *Client) DecompressFileCtx(ctx context.Context, root, file string) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/decompress", c.baseURL, c.serverID)
+	
+	req := DecompressRequest{
+		Root: root,
+		File: file,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(req).
+		Post(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to decompress file: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// CopyFile duplicates a file in place, the copy gets a " copy" suffix
+func (c *Client) CopyFile(location string) error {
+	return c.CopyFileCtx(context.Background(), location)
+}
+
+// CopyFileCtx is like CopyFile but cancelling ctx aborts the request
+func (c *Client) CopyFileCtx(ctx context.Context, location string) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/copy", c.baseURL, c.serverID)
+	
+	body := map[string]string{
+		"location": location,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		Post(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to copy file: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// ChmodFiles changes the permissions of one or more files in a directory
+func (c *Client) ChmodFiles(root string, files []ChmodFile) error {
+	return c.ChmodFilesCtx(context.Background(), root, files)
+}
+
+// ChmodFilesCtx is like ChmodFiles but cancelling ctx aborts the request
+func (c *Client) ChmodFilesCtx(ctx context.Context, root string, files []ChmodFile) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/chmod", c.baseURL, c.serverID)
+	
+	req := ChmodRequest{
+		Root:  root,
+		Files: files,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(req).
+		Post(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to change file permissions: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
 	}
 
 	return nil
 }
 
-// DownloadFile gets a download URL for a file
+// PullRemoteFile makes the daemon download a URL directly into a server directory
+// The download continues in the background after this returns
+func (c *Client) PullRemoteFile(req PullRequest) error {
+	return c.PullRemoteFileCtx(context.Background(), req)
+}
+
+// PullRemoteFileCtx is like PullRemoteFile but cancelling ctx aborts the request
+func (c *Client) PullRemoteFileCtx(ctx context.Context, req PullRequest) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/pull", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(req).
+		Post(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to pull remote file: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// GetDownloadURL gets a signed download URL for a file
 func (c *Client) GetDownloadURL(path string) (string, error) {
+	return c.GetDownloadURLCtx(context.Background(), path)
+}
+
+// GetDownloadURLCtx is like GetDownloadURL but cancelling ctx aborts the request
+func (c *Client) GetDownloadURLCtx(ctx context.Context, path string) (string, error) {
 	encodedPath := url.QueryEscape(path)
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/download?file=%s", c.baseURL, c.serverID, encodedPath)
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		Get(endpoint)
 
 	if err != nil {
@@ -443,7 +824,7 @@ func (c *Client) GetDownloadURL(path string) (string, error) {
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return "", fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return "", newAPIError(resp)
 	}
 
 	var result struct {
@@ -461,18 +842,34 @@ func (c *Client) GetDownloadURL(path string) (string, error) {
 
 // UploadFile uploads a file to the server
 func (c *Client) UploadFile(path string, filename string, content io.Reader) error {
-	// First, get upload URL
+	return c.UploadFileCtx(context.Background(), path, filename, content)
+}
+
+// UploadFileCtx is like UploadFile but cancelling ctx aborts the upload
+func (c *Client) UploadFileCtx(ctx context.Context, path string, filename string, content io.Reader) error {
+	// Readers such as bytes.Reader and strings.Reader know their remaining size
+	size := int64(-1)
+	if sized, ok := content.(interface{ Len() int }); ok {
+		size = int64(sized.Len())
+	}
+	
+	return c.UploadStreamCtx(ctx, path, filename, content, size)
+}
+
+// getUploadURL gets a signed upload URL, bound to ctx
+func (c *Client) getUploadURL(ctx context.Context) (string, error) {
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/files/upload", c.baseURL, c.serverID)
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		Get(endpoint)
 
 	if err != nil {
-		return fmt.Errorf("failed to get upload URL: %w", err)
+		return "", fmt.Errorf("failed to get upload URL: %w", err)
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return "", newAPIError(resp)
 	}
 
 	var uploadInfo struct {
@@ -482,28 +879,46 @@ func (c *Client) UploadFile(path string, filename string, content io.Reader) err
 	}
 
 	if err := json.Unmarshal(resp.Body(), &uploadInfo); err != nil {
-		return fmt.Errorf("failed to parse upload info: %w", err)
+		return "", fmt.Errorf("failed to parse upload info: %w", err)
 	}
 
-	// Now upload the file
-	uploadClient := resty.New()
-	uploadResp, err := uploadClient.R().
-		SetFileReader("files", filename, content).
-		Post(uploadInfo.Attributes.URL + "&directory=" + url.QueryEscape(path))
+	return uploadInfo.Attributes.URL, nil
+}
+
+// StatFile returns information about a single file by listing its parent directory
+// It returns nil without an error if the file does not exist
+func (c *Client) StatFile(filePath string) (*FileInfo, error) {
+	return c.StatFileCtx(context.Background(), filePath)
+}
 
+// StatFileCtx is like StatFile but cancelling ctx aborts the request
+func (c *Client) StatFileCtx(ctx context.Context, filePath string) (*FileInfo, error) {
+	dir, name := path.Split(path.Clean("/" + filePath))
+	
+	files, err := c.ListFilesCtx(ctx, dir)
 	if err != nil {
-		return fmt.Errorf("failed to upload file: %w", err)
+		if IsNotFound(err) {
+			return nil, nil // Parent directory doesn't exist either
+		}
+		return nil, err
 	}
-
-	if uploadResp.StatusCode() != http.StatusOK && uploadResp.StatusCode() != http.StatusNoContent {
-		return fmt.Errorf("upload returned status %d: %s", uploadResp.StatusCode(), uploadResp.String())
+	
+	for i := range files {
+		if files[i].Name == name {
+			return &files[i], nil
+		}
 	}
-
-	return nil
+	
+	return nil, nil
 }
 
 // TestConnection tests if the API connection is working
 func (c *Client) TestConnection() error {
+	return c.TestConnectionCtx(context.Background())
+}
+
+// TestConnectionCtx is like TestConnection but cancelling ctx aborts the request
+func (c *Client) TestConnectionCtx(ctx context.Context) error {
 	var endpoint string
 	
 	if c.isAdmin {
@@ -514,13 +929,13 @@ func (c *Client) TestConnection() error {
 		endpoint = fmt.Sprintf("%s/api/client/servers/%s", c.baseURL, c.serverID)
 	}
 	
-	resp, err := c.client.R().Get(endpoint)
+	resp, err := c.client.R().SetContext(ctx).Get(endpoint)
 	if err != nil {
 		return fmt.Errorf("connection failed: %w", err)
 	}
 
 	if resp.StatusCode() != http.StatusOK {
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode(), resp.String())
+		return newAPIError(resp)
 	}
 
 	return nil
@@ -528,6 +943,11 @@ func (c *Client) TestConnection() error {
 
 // SendConsoleCommand sends a command to the server console
 func (c *Client) SendConsoleCommand(command string) error {
+	return c.SendConsoleCommandCtx(context.Background(), command)
+}
+
+// SendConsoleCommandCtx is like SendConsoleCommand but cancelling ctx aborts the request
+func (c *Client) SendConsoleCommandCtx(ctx context.Context, command string) error {
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/command", c.baseURL, c.serverID)
 	
 	payload := map[string]string{
@@ -535,6 +955,7 @@ func (c *Client) SendConsoleCommand(command string) error {
 	}
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetBody(payload).
 		Post(endpoint)
 	
@@ -543,7 +964,7 @@ func (c *Client) SendConsoleCommand(command string) error {
 	}
 	
 	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
-		return fmt.Errorf("failed to send command: status %d", resp.StatusCode())
+		return newAPIError(resp)
 	}
 	
 	return nil
@@ -551,32 +972,26 @@ func (c *Client) SendConsoleCommand(command string) error {
 
 // GetServerState gets the current server power state
 func (c *Client) GetServerState() (string, error) {
-	endpoint := fmt.Sprintf("%s/api/client/servers/%s/resources", c.baseURL, c.serverID)
-	
-	resp, err := c.client.R().Get(endpoint)
+	return c.GetServerStateCtx(context.Background())
+}
+
+// GetServerStateCtx is like GetServerState but cancelling ctx aborts the request
+func (c *Client) GetServerStateCtx(ctx context.Context) (string, error) {
+	resources, err := c.GetResourcesCtx(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get server state: %w", err)
-	}
-	
-	if resp.StatusCode() != http.StatusOK {
-		return "", fmt.Errorf("API returned status %d", resp.StatusCode())
-	}
-	
-	var result struct {
-		Attributes struct {
-			CurrentState string `json:"current_state"`
-		} `json:"attributes"`
-	}
-	
-	if err := json.Unmarshal(resp.Body(), &result); err != nil {
 		return "", err
 	}
 	
-	return result.Attributes.CurrentState, nil
+	return resources.CurrentState, nil
 }
 
 // SetPowerState sets the server power state (start, stop, restart, kill)
 func (c *Client) SetPowerState(state string) error {
+	return c.SetPowerStateCtx(context.Background(), state)
+}
+
+// SetPowerStateCtx is like SetPowerState but cancelling ctx aborts the request
+func (c *Client) SetPowerStateCtx(ctx context.Context, state string) error {
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/power", c.baseURL, c.serverID)
 	
 	payload := map[string]string{
@@ -584,6 +999,7 @@ func (c *Client) SetPowerState(state string) error {
 	}
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		SetBody(payload).
 		Post(endpoint)
 	
@@ -592,7 +1008,7 @@ func (c *Client) SetPowerState(state string) error {
 	}
 	
 	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
-		return fmt.Errorf("failed to set power state: status %d", resp.StatusCode())
+		return newAPIError(resp)
 	}
 	
 	return nil
@@ -606,9 +1022,15 @@ type WebSocketCredentials struct {
 
 // GetWebSocketCredentials retrieves WebSocket credentials for console connection
 func (c *Client) GetWebSocketCredentials() (*WebSocketCredentials, error) {
+	return c.GetWebSocketCredentialsCtx(context.Background())
+}
+
+// GetWebSocketCredentialsCtx is like GetWebSocketCredentials but cancelling ctx aborts the request
+func (c *Client) GetWebSocketCredentialsCtx(ctx context.Context) (*WebSocketCredentials, error) {
 	endpoint := fmt.Sprintf("%s/api/client/servers/%s/websocket", c.baseURL, c.serverID)
 	
 	resp, err := c.client.R().
+		SetContext(ctx).
 		Get(endpoint)
 	
 	if err != nil {
@@ -616,7 +1038,7 @@ func (c *Client) GetWebSocketCredentials() (*WebSocketCredentials, error) {
 	}
 	
 	if resp.StatusCode() != http.StatusOK {
-		return nil, fmt.Errorf("failed to get WebSocket credentials: status %d", resp.StatusCode())
+		return nil, newAPIError(resp)
 	}
 	
 	var response struct {
@@ -0,0 +1,27 @@
+package pterodactyl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNewClientDetectsAPITypeWithoutRetries(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "")
+	defer c.Close()
+
+	if c.IsAdmin() {
+		t.Error("IsAdmin() = true for a failing panel")
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("detection sent %d requests, want 1", got)
+	}
+}
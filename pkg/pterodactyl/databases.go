@@ -0,0 +1,177 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DatabaseHost represents the host a database lives on
+type DatabaseHost struct {
+	Address string `json:"address"`
+	Port    int    `json:"port"`
+}
+
+// Database represents a server database with its connection info
+type Database struct {
+	ID              string       `json:"id"`
+	Name            string       `json:"name"`
+	Username        string       `json:"username"`
+	Host            DatabaseHost `json:"host"`
+	ConnectionsFrom string       `json:"connections_from"`
+	MaxConnections  int          `json:"max_connections"`
+	Password        string       `json:"password,omitempty"`
+}
+
+// DatabaseObject represents a database object in the API response
+type DatabaseObject struct {
+	Object     string `json:"object"`
+	Attributes struct {
+		Database
+		Relationships struct {
+			Password struct {
+				Attributes struct {
+					Password string `json:"password"`
+				} `json:"attributes"`
+			} `json:"password"`
+		} `json:"relationships"`
+	} `json:"attributes"`
+}
+
+// ListDatabasesResponse represents the response from the list databases endpoint
+type ListDatabasesResponse struct {
+	Object string           `json:"object"`
+	Data   []DatabaseObject `json:"data"`
+}
+
+// toDatabase converts a database object to a Database including its password
+func (do DatabaseObject) toDatabase() Database {
+	db := do.Attributes.Database
+	db.Password = do.Attributes.Relationships.Password.Attributes.Password
+	return db
+}
+
+// JDBCURL returns a JDBC connection URL for the database, as used by most Minecraft plugins
+func (d Database) JDBCURL() string {
+	return fmt.Sprintf("jdbc:mysql://%s:%d/%s", d.Host.Address, d.Host.Port, d.Name)
+}
+
+// ListDatabases lists all databases of the server including their passwords
+func (c *Client) ListDatabases() ([]Database, error) {
+	return c.ListDatabasesCtx(context.Background())
+}
+
+// ListDatabasesCtx is like ListDatabases but cancelling ctx aborts the request
+func (c *Client) ListDatabasesCtx(ctx context.Context) ([]Database, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/databases", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("include", "password").
+		SetResult(&ListDatabasesResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list databases: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListDatabasesResponse)
+
+	databases := make([]Database, len(result.Data))
+	for i, obj := range result.Data {
+		databases[i] = obj.toDatabase()
+	}
+
+	return databases, nil
+}
+
+// CreateDatabase creates a new database
+// remote is the host pattern connections are allowed from, e.g. "%" or "10.0.0.%"
+func (c *Client) CreateDatabase(name, remote string) (*Database, error) {
+	return c.CreateDatabaseCtx(context.Background(), name, remote)
+}
+
+// CreateDatabaseCtx is like CreateDatabase but cancelling ctx aborts the request
+func (c *Client) CreateDatabaseCtx(ctx context.Context, name, remote string) (*Database, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/databases", c.baseURL, c.serverID)
+
+	if remote == "" {
+		remote = "%"
+	}
+
+	body := map[string]string{
+		"database": name,
+		"remote":   remote,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		SetResult(&DatabaseObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	db := resp.Result().(*DatabaseObject).toDatabase()
+	return &db, nil
+}
+
+// RotateDatabasePassword generates a new password for a database
+func (c *Client) RotateDatabasePassword(databaseID string) (*Database, error) {
+	return c.RotateDatabasePasswordCtx(context.Background(), databaseID)
+}
+
+// RotateDatabasePasswordCtx is like RotateDatabasePassword but cancelling ctx aborts the request
+func (c *Client) RotateDatabasePasswordCtx(ctx context.Context, databaseID string) (*Database, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/databases/%s/rotate-password", c.baseURL, c.serverID, databaseID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&DatabaseObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate database password: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	db := resp.Result().(*DatabaseObject).toDatabase()
+	return &db, nil
+}
+
+// DeleteDatabase deletes a database
+func (c *Client) DeleteDatabase(databaseID string) error {
+	return c.DeleteDatabaseCtx(context.Background(), databaseID)
+}
+
+// DeleteDatabaseCtx is like DeleteDatabase but cancelling ctx aborts the request
+func (c *Client) DeleteDatabaseCtx(ctx context.Context, databaseID string) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/databases/%s", c.baseURL, c.serverID, databaseID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Delete(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete database: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package pterodactyl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// maxErrorBody caps the raw body kept in an APIError that isn't a JSON error response
+const maxErrorBody = 1024
+
+// APIError represents an error response from the panel or the daemon
+type APIError struct {
+	Status int              // HTTP status code of the response
+	Code   string           // Error code of the first error, e.g. "NotFoundHttpException"
+	Detail string           // Message of the first error
+	Source string           // Request field of the first error, set for validation errors
+	Errors []APIErrorDetail // All errors of the response
+	Body   string           // Raw body, when the response isn't a JSON error response
+}
+
+// APIErrorDetail represents a single entry of the errors array of a response
+type APIErrorDetail struct {
+	Code   string `json:"code"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Source struct {
+		Field string `json:"field"`
+	} `json:"source"`
+}
+
+// Error returns the status and the details of all errors
+func (e *APIError) Error() string {
+	msg := e.Body
+	if len(e.Errors) > 0 {
+		details := make([]string, len(e.Errors))
+		for i, detail := range e.Errors {
+			details[i] = detail.Detail
+		}
+		msg = strings.Join(details, "; ")
+	} else if e.Detail != "" {
+		msg = e.Detail
+	}
+
+	return fmt.Sprintf("API returned status %d: %s", e.Status, msg)
+}
+
+// newAPIError creates an APIError from an unsuccessful response
+func newAPIError(resp *resty.Response) error {
+	return parseAPIError(resp.StatusCode(), resp.Body())
+}
+
+// parseAPIError creates an APIError from a status code and response body. The
+// panel responds with an "errors" array, the daemon with a single "error".
+func parseAPIError(status int, body []byte) *APIError {
+	apiErr := &APIError{Status: status}
+
+	var payload struct {
+		Errors []APIErrorDetail `json:"errors"`
+		Error  string           `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && (len(payload.Errors) > 0 || payload.Error != "") {
+		if len(payload.Errors) > 0 {
+			first := payload.Errors[0]
+			apiErr.Code = first.Code
+			apiErr.Detail = first.Detail
+			apiErr.Source = first.Source.Field
+			apiErr.Errors = payload.Errors
+		} else {
+			apiErr.Detail = payload.Error
+		}
+		return apiErr
+	}
+
+	if len(body) > maxErrorBody {
+		body = body[:maxErrorBody]
+	}
+	apiErr.Body = strings.TrimSpace(string(body))
+	return apiErr
+}
+
+// AsAPIError returns the APIError in err's chain, if any
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
+
+// hasStatus reports whether err is an APIError with the given status
+func hasStatus(err error, status int) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.Status == status
+}
+
+// IsNotFound reports whether err is a 404 response
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsForbidden reports whether err is a 403 response, e.g. a missing subuser permission
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is a 409 response, e.g. a server that is
+// still installing or a resource that already exists
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsRateLimited reports whether err is a 429 response
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsClientError reports whether err is a 4xx response that repeating the same
+// request won't fix, e.g. a missing permission, a file that is too large or
+// invalid input. Timeouts (408) and rate limits (429) are not included.
+func IsClientError(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok || apiErr.Status < 400 || apiErr.Status >= 500 {
+		return false
+	}
+	return apiErr.Status != http.StatusRequestTimeout && apiErr.Status != http.StatusTooManyRequests
+}
+
+// IsDaemonUnavailable reports whether the panel could not reach the daemon (Wings) of the server
+// The panel often reports an unreachable daemon as a plain 500, so a 500 without
+// another exception code counts as well.
+func IsDaemonUnavailable(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+
+	switch apiErr.Status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusInternalServerError:
+		if apiErr.Code == "" {
+			return true
+		}
+	}
+	return apiErr.Code == "DaemonConnectionException"
+}
@@ -0,0 +1,38 @@
+package pterodactyl
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIsDaemonUnavailable(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{"bad gateway", 502, `<html>Bad Gateway</html>`, true},
+		{"service unavailable", 503, ``, true},
+		{"gateway timeout", 504, ``, true},
+		{"plain 500", 500, `<html>Server Error</html>`, true},
+		{"500 with daemon error", 500, `{"error":"failed to connect"}`, true},
+		{"daemon connection exception", 500, `{"errors":[{"code":"DaemonConnectionException","status":"500","detail":"unreachable"}]}`, true},
+		{"500 with other exception", 500, `{"errors":[{"code":"QueryException","status":"500","detail":"database error"}]}`, false},
+		{"not found", 404, `{"errors":[{"code":"NotFoundHttpException","status":"404","detail":"not found"}]}`, false},
+		{"forbidden", 403, ``, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("request failed: %w", parseAPIError(tt.status, []byte(tt.body)))
+			if got := IsDaemonUnavailable(err); got != tt.want {
+				t.Errorf("IsDaemonUnavailable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if IsDaemonUnavailable(fmt.Errorf("connection refused")) {
+		t.Error("IsDaemonUnavailable() = true for an error without a response")
+	}
+}
@@ -0,0 +1,116 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Location represents a panel location that groups nodes
+type Location struct {
+	ID        int       `json:"id"`
+	Short     string    `json:"short"` // Short code, e.g. "us.nyc"
+	Long      string    `json:"long"`  // Description
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LocationObject represents a location object in the API response
+type LocationObject struct {
+	Object     string   `json:"object"`
+	Attributes Location `json:"attributes"`
+}
+
+// ListLocationsResponse represents the response from the list locations endpoint
+type ListLocationsResponse struct {
+	Object string           `json:"object"`
+	Data   []LocationObject `json:"data"`
+	Meta   struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// ListLocations lists all locations of the panel
+func (c *Client) ListLocations() ([]Location, error) {
+	return c.ListLocationsCtx(context.Background())
+}
+
+// ListLocationsCtx is like ListLocations but cancelling ctx aborts the requests
+func (c *Client) ListLocationsCtx(ctx context.Context) ([]Location, error) {
+	return c.IterateLocationsCtx(ctx).All()
+}
+
+// IterateLocations iterates over the locations of the panel
+func (c *Client) IterateLocations() *Iterator[Location] {
+	return c.IterateLocationsCtx(context.Background())
+}
+
+// IterateLocationsCtx is like IterateLocations but cancelling ctx aborts the requests
+func (c *Client) IterateLocationsCtx(ctx context.Context) *Iterator[Location] {
+	return newIterator(ctx, c.listLocations)
+}
+
+// listLocations lists one page of locations
+func (c *Client) listLocations(ctx context.Context, page int) ([]Location, Pagination, error) {
+	if err := c.requireAdmin(); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/application/locations", c.baseURL)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("page", strconv.Itoa(page)).
+		SetQueryParam("per_page", strconv.Itoa(applicationPerPage)).
+		SetResult(&ListLocationsResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, Pagination{}, fmt.Errorf("failed to list locations: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, Pagination{}, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListLocationsResponse)
+
+	locations := make([]Location, len(result.Data))
+	for i, obj := range result.Data {
+		locations[i] = obj.Attributes
+	}
+
+	return locations, result.Meta.Pagination, nil
+}
+
+// GetLocation gets a single location
+func (c *Client) GetLocation(locationID int) (*Location, error) {
+	return c.GetLocationCtx(context.Background(), locationID)
+}
+
+// GetLocationCtx is like GetLocation but cancelling ctx aborts the request
+func (c *Client) GetLocationCtx(ctx context.Context, locationID int) (*Location, error) {
+	if err := c.requireAdmin(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/application/locations/%d", c.baseURL, locationID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&LocationObject{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*LocationObject)
+	return &result.Attributes, nil
+}
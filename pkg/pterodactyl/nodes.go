@@ -0,0 +1,339 @@
+package pterodactyl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Node represents a Wings node of the panel. Memory and disk are in MiB; the
+// overallocate values are percentages, -1 disables the limit check.
+type Node struct {
+	ID                 int       `json:"id"`
+	UUID               string    `json:"uuid"`
+	Public             bool      `json:"public"`
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	LocationID         int       `json:"location_id"`
+	FQDN               string    `json:"fqdn"`
+	Scheme             string    `json:"scheme"`
+	BehindProxy        bool      `json:"behind_proxy"`
+	MaintenanceMode    bool      `json:"maintenance_mode"`
+	Memory             int64     `json:"memory"`
+	MemoryOverallocate int64     `json:"memory_overallocate"`
+	Disk               int64     `json:"disk"`
+	DiskOverallocate   int64     `json:"disk_overallocate"`
+	UploadSize         int64     `json:"upload_size"` // MiB
+	DaemonListen       int       `json:"daemon_listen"`
+	DaemonSFTP         int       `json:"daemon_sftp"`
+	DaemonBase         string    `json:"daemon_base"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// NodeObject represents a node object in the API response
+type NodeObject struct {
+	Object     string `json:"object"`
+	Attributes Node   `json:"attributes"`
+}
+
+// ListNodesResponse represents the response from the list nodes endpoint
+type ListNodesResponse struct {
+	Object string       `json:"object"`
+	Data   []NodeObject `json:"data"`
+	Meta   struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// NodeAllocation represents an ip:port of a node that can be assigned to a server
+type NodeAllocation struct {
+	ID       int    `json:"id"`
+	IP       string `json:"ip"`
+	Alias    string `json:"alias"`
+	Port     int    `json:"port"`
+	Notes    string `json:"notes"`
+	Assigned bool   `json:"assigned"`
+}
+
+// ListNodeAllocationsResponse represents the response from the node allocations endpoint
+type ListNodeAllocationsResponse struct {
+	Object string `json:"object"`
+	Data   []struct {
+		Attributes NodeAllocation `json:"attributes"`
+	} `json:"data"`
+	Meta struct {
+		Pagination Pagination `json:"pagination"`
+	} `json:"meta"`
+}
+
+// NodeResources totals the resources allocated to the servers of a node
+// against the node's limits. All sizes are in MiB; a limit of 0 means the
+// node does not restrict allocation.
+type NodeResources struct {
+	Servers     int   `json:"servers"`
+	Memory      int64 `json:"memory"`       // Memory allocated to servers
+	MemoryTotal int64 `json:"memory_total"` // Physical memory of the node
+	MemoryLimit int64 `json:"memory_limit"` // Memory that may be allocated, including overallocation
+	Disk        int64 `json:"disk"`
+	DiskTotal   int64 `json:"disk_total"`
+	DiskLimit   int64 `json:"disk_limit"`
+}
+
+// TotalNodeResources adds up the limits of a node's servers
+func TotalNodeResources(node Node, servers []ServerLimits) NodeResources {
+	totals := NodeResources{
+		Servers:     len(servers),
+		MemoryTotal: node.Memory,
+		MemoryLimit: overallocated(node.Memory, node.MemoryOverallocate),
+		DiskTotal:   node.Disk,
+		DiskLimit:   overallocated(node.Disk, node.DiskOverallocate),
+	}
+
+	for _, limits := range servers {
+		totals.Memory += limits.Memory
+		totals.Disk += limits.Disk
+	}
+
+	return totals
+}
+
+// overallocated returns the amount that may be allocated from total, or 0
+// if overallocation is unlimited
+func overallocated(total, percent int64) int64 {
+	if percent < 0 {
+		return 0
+	}
+	return total + total*percent/100
+}
+
+// ListNodes lists all nodes of the panel
+func (c *Client) ListNodes() ([]Node, error) {
+	return c.ListNodesCtx(context.Background())
+}
+
+// ListNodesCtx is like ListNodes but cancelling ctx aborts the requests
+func (c *Client) ListNodesCtx(ctx context.Context) ([]Node, error) {
+	return c.IterateNodesCtx(ctx).All()
+}
+
+// IterateNodes iterates over the nodes of the panel
+func (c *Client) IterateNodes() *Iterator[Node] {
+	return c.IterateNodesCtx(context.Background())
+}
+
+// IterateNodesCtx is like IterateNodes but cancelling ctx aborts the requests
+func (c *Client) IterateNodesCtx(ctx context.Context) *Iterator[Node] {
+	return newIterator(ctx, c.listNodes)
+}
+
+// listNodes lists one page of nodes
+func (c *Client) listNodes(ctx context.Context, page int) ([]Node, Pagination, error) {
+	if err := c.requireAdmin(); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/application/nodes", c.baseURL)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("page", strconv.Itoa(page)).
+		SetQueryParam("per_page", strconv.Itoa(applicationPerPage)).
+		SetResult(&ListNodesResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, Pagination{}, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, Pagination{}, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListNodesResponse)
+
+	nodes := make([]Node, len(result.Data))
+	for i, obj := range result.Data {
+		nodes[i] = obj.Attributes
+	}
+
+	return nodes, result.Meta.Pagination, nil
+}
+
+// GetNode gets the details of a node
+func (c *Client) GetNode(nodeID int) (*Node, error) {
+	return c.GetNodeCtx(context.Background(), nodeID)
+}
+
+// GetNodeCtx is like GetNode but cancelling ctx aborts the request
+func (c *Client) GetNodeCtx(ctx context.Context, nodeID int) (*Node, error) {
+	if err := c.requireAdmin(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/application/nodes/%d", c.baseURL, nodeID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&NodeObject{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*NodeObject)
+	return &result.Attributes, nil
+}
+
+// GetNodeConfiguration gets the Wings configuration of a node, as written to
+// its config.yml. It contains the node's daemon token.
+func (c *Client) GetNodeConfiguration(nodeID int) (map[string]interface{}, error) {
+	return c.GetNodeConfigurationCtx(context.Background(), nodeID)
+}
+
+// GetNodeConfigurationCtx is like GetNodeConfiguration but cancelling ctx aborts the request
+func (c *Client) GetNodeConfigurationCtx(ctx context.Context, nodeID int) (map[string]interface{}, error) {
+	if err := c.requireAdmin(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/application/nodes/%d/configuration", c.baseURL, nodeID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node configuration: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var configuration map[string]interface{}
+	if err := json.Unmarshal(resp.Body(), &configuration); err != nil {
+		return nil, fmt.Errorf("failed to parse node configuration: %w", err)
+	}
+
+	return configuration, nil
+}
+
+// ListNodeAllocations lists all allocations of a node
+func (c *Client) ListNodeAllocations(nodeID int) ([]NodeAllocation, error) {
+	return c.ListNodeAllocationsCtx(context.Background(), nodeID)
+}
+
+// ListNodeAllocationsCtx is like ListNodeAllocations but cancelling ctx aborts the requests
+func (c *Client) ListNodeAllocationsCtx(ctx context.Context, nodeID int) ([]NodeAllocation, error) {
+	return c.IterateNodeAllocationsCtx(ctx, nodeID).All()
+}
+
+// IterateNodeAllocations iterates over the allocations of a node
+func (c *Client) IterateNodeAllocations(nodeID int) *Iterator[NodeAllocation] {
+	return c.IterateNodeAllocationsCtx(context.Background(), nodeID)
+}
+
+// IterateNodeAllocationsCtx is like IterateNodeAllocations but cancelling ctx aborts the requests
+func (c *Client) IterateNodeAllocationsCtx(ctx context.Context, nodeID int) *Iterator[NodeAllocation] {
+	return newIterator(ctx, func(ctx context.Context, page int) ([]NodeAllocation, Pagination, error) {
+		return c.listNodeAllocations(ctx, nodeID, page)
+	})
+}
+
+// listNodeAllocations lists one page of a node's allocations
+func (c *Client) listNodeAllocations(ctx context.Context, nodeID, page int) ([]NodeAllocation, Pagination, error) {
+	if err := c.requireAdmin(); err != nil {
+		return nil, Pagination{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/application/nodes/%d/allocations", c.baseURL, nodeID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("page", strconv.Itoa(page)).
+		SetQueryParam("per_page", strconv.Itoa(applicationPerPage)).
+		SetResult(&ListNodeAllocationsResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, Pagination{}, fmt.Errorf("failed to list node allocations: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, Pagination{}, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListNodeAllocationsResponse)
+
+	allocations := make([]NodeAllocation, len(result.Data))
+	for i, obj := range result.Data {
+		allocations[i] = obj.Attributes
+	}
+
+	return allocations, result.Meta.Pagination, nil
+}
+
+// GetNodeResources totals the memory and disk allocated to a node's servers
+// against the node's limits
+func (c *Client) GetNodeResources(nodeID int) (*NodeResources, error) {
+	return c.GetNodeResourcesCtx(context.Background(), nodeID)
+}
+
+// GetNodeResourcesCtx is like GetNodeResources but cancelling ctx aborts the request
+func (c *Client) GetNodeResourcesCtx(ctx context.Context, nodeID int) (*NodeResources, error) {
+	if err := c.requireAdmin(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/application/nodes/%d", c.baseURL, nodeID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("include", "servers").
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var result struct {
+		Attributes struct {
+			Node
+			Relationships struct {
+				Servers struct {
+					Data []struct {
+						Attributes struct {
+							Limits ServerLimits `json:"limits"`
+						} `json:"attributes"`
+					} `json:"data"`
+				} `json:"servers"`
+			} `json:"relationships"`
+		} `json:"attributes"`
+	}
+
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse node: %w", err)
+	}
+
+	servers := result.Attributes.Relationships.Servers.Data
+	limits := make([]ServerLimits, len(servers))
+	for i, server := range servers {
+		limits[i] = server.Attributes.Limits
+	}
+
+	totals := TotalNodeResources(result.Attributes.Node, limits)
+	return &totals, nil
+}
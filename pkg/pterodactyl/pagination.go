@@ -0,0 +1,133 @@
+package pterodactyl
+
+import (
+	"context"
+)
+
+const (
+	// clientPerPage is the page size used for client API listings
+	clientPerPage = 50
+	// applicationPerPage is the page size used for application API listings
+	applicationPerPage = 100
+)
+
+// Server list types of the client API, see ServerFilter.Type
+const (
+	ServerTypeOwner    = "owner"     // Servers owned by the user
+	ServerTypeAdmin    = "admin"     // Servers the user can access as a root admin but doesn't own
+	ServerTypeAdminAll = "admin-all" // Every server on the panel, for root admins
+)
+
+// ServerFilter narrows a server listing
+type ServerFilter struct {
+	Name string // Partial match on the server name (filter[name])
+	// Type selects which servers a client API key lists (type=...), one of the
+	// ServerType constants. Application API keys always list every server.
+	Type string
+}
+
+// pageFetcher fetches one page of a listing, starting at page 1
+type pageFetcher[T any] func(ctx context.Context, page int) ([]T, Pagination, error)
+
+// Iterator walks a paginated listing, fetching the next page when the
+// current one is used up:
+//
+//	it := client.IterateServers(ServerFilter{})
+//	for it.Next() {
+//		server := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx        context.Context
+	fetch      pageFetcher[T]
+	page       int // Last fetched page
+	items      []T
+	pos        int
+	item       T
+	pagination Pagination
+	done       bool
+	err        error
+}
+
+// newIterator creates an iterator that fetches pages with fetch
+func newIterator[T any](ctx context.Context, fetch pageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{ctx: ctx, fetch: fetch}
+}
+
+// Next advances to the next item and reports whether there is one. It
+// returns false at the end of the listing or when fetching a page failed.
+func (it *Iterator[T]) Next() bool {
+	for it.pos >= len(it.items) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetchPage()
+	}
+
+	it.item = it.items[it.pos]
+	it.pos++
+	return true
+}
+
+// fetchPage fetches the page after the last one
+func (it *Iterator[T]) fetchPage() {
+	page := it.page + 1
+	items, pagination, err := it.fetch(it.ctx, page)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page = page
+	it.items = items
+	it.pos = 0
+	it.pagination = pagination
+
+	// An empty page also ends the listing, in case the meta is missing
+	if len(items) == 0 || page >= pagination.TotalPages {
+		it.done = true
+	}
+}
+
+// Item returns the current item
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Pagination returns the pagination of the last fetched page, e.g. for the
+// total number of items
+func (it *Iterator[T]) Pagination() Pagination {
+	return it.pagination
+}
+
+// All returns the remaining items of the listing
+func (it *Iterator[T]) All() ([]T, error) {
+	var items []T
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	return items, it.Err()
+}
+
+// IterateServers iterates over the servers matching filter, using the
+// application API for admin keys and the client API otherwise
+func (c *Client) IterateServers(filter ServerFilter) *Iterator[ServerInfo] {
+	return c.IterateServersCtx(context.Background(), filter)
+}
+
+// IterateServersCtx is like IterateServers but cancelling ctx aborts the requests
+func (c *Client) IterateServersCtx(ctx context.Context, filter ServerFilter) *Iterator[ServerInfo] {
+	return newIterator(ctx, func(ctx context.Context, page int) ([]ServerInfo, Pagination, error) {
+		if c.isAdmin {
+			return c.listServersAdmin(ctx, filter, page)
+		}
+		return c.listServersClient(ctx, filter, page)
+	})
+}
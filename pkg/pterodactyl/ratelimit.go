@@ -0,0 +1,215 @@
+package pterodactyl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// maxRetries is the number of times a request is retried
+	maxRetries = 3
+	// retryWaitTime is the base of the jittered exponential backoff between retries
+	retryWaitTime = 500 * time.Millisecond
+	// retryMaxWaitTime caps the wait before a retry, including Retry-After
+	retryMaxWaitTime = time.Minute
+	// budgetWindow is the window of the client-side request budget
+	budgetWindow = time.Minute
+)
+
+// RateLimit represents the request quota of a panel as last reported by its
+// X-RateLimit-* headers
+type RateLimit struct {
+	Limit     int       `json:"limit"`     // Requests allowed per window, 0 if unknown
+	Remaining int       `json:"remaining"` // Requests left in the current window
+	Reset     time.Time `json:"reset"`     // When the quota resets, zero if unknown
+	Budget    int       `json:"budget"`    // Client-side requests per minute, 0 if unlimited
+	UpdatedAt time.Time `json:"updatedAt"` // When the headers were last seen, zero if never
+}
+
+// rateLimiter paces requests of a client. It waits out Retry-After and
+// exhausted quotas before sending, and enforces an optional request budget.
+type rateLimiter struct {
+	mu           sync.Mutex
+	quota        RateLimit
+	blockedUntil time.Time   // Set from Retry-After of a 429 response
+	sent         []time.Time // Send times within the budget window
+}
+
+// SetRequestBudget limits the client to perMinute requests per minute, shared
+// with all clients derived through ForServer. 0 removes the limit.
+func (c *Client) SetRequestBudget(perMinute int) {
+	if perMinute < 0 {
+		perMinute = 0
+	}
+
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	c.limiter.quota.Budget = perMinute
+}
+
+// RateLimit returns the last known request quota of the panel
+func (c *Client) RateLimit() RateLimit {
+	c.limiter.mu.Lock()
+	defer c.limiter.mu.Unlock()
+	return c.limiter.quota
+}
+
+// setupRetries installs the rate limit middleware and retry policy on the resty client
+func (c *Client) setupRetries() {
+	c.client.
+		SetRetryCount(maxRetries).
+		SetRetryWaitTime(retryWaitTime).
+		SetRetryMaxWaitTime(retryMaxWaitTime).
+		AddRetryCondition(shouldRetry).
+		SetRetryAfter(retryAfter).
+		OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+			return c.limiter.wait(req.Context())
+		}).
+		OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+			c.limiter.update(resp.RawResponse)
+			return nil
+		})
+}
+
+// shouldRetry retries rate limited requests of any method, since they were
+// not processed, and idempotent requests on server and network errors
+func shouldRetry(resp *resty.Response, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if resp == nil || resp.Request == nil {
+		return false
+	}
+
+	if err == nil && resp.StatusCode() == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch resp.Request.Method {
+	case http.MethodGet, http.MethodHead:
+		return err != nil || resp.StatusCode() >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// retryAfter waits as long as a 429 response asks. Other retries return 0 to
+// use the jittered exponential backoff.
+func retryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	if resp.StatusCode() != http.StatusTooManyRequests {
+		return 0, nil
+	}
+	return parseRetryAfter(resp.RawResponse), nil
+}
+
+// parseRetryAfter returns the wait requested by the Retry-After or
+// X-RateLimit-Reset header of a response, or 0 if there is none
+func parseRetryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if at, err := http.ParseTime(value); err == nil {
+			return time.Until(at)
+		}
+	}
+
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		return time.Until(time.Unix(reset, 0))
+	}
+
+	return 0
+}
+
+// update records the quota reported by a response
+func (l *rateLimiter) update(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit")); err == nil {
+		l.quota.Limit = limit
+		l.quota.UpdatedAt = now
+	}
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		l.quota.Remaining = remaining
+		l.quota.UpdatedAt = now
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		l.quota.Reset = time.Unix(reset, 0)
+	} else if !l.quota.Reset.After(now) {
+		l.quota.Reset = time.Time{}
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if wait := parseRetryAfter(resp); wait > 0 {
+			l.blockedUntil = now.Add(wait)
+		}
+	}
+}
+
+// wait blocks until a request may be sent and records it against the budget
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve(time.Now())
+		if delay <= 0 {
+			return nil
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve records a request sent at now, or returns how long to wait first
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+
+	// The panel reported an exhausted quota that hasn't reset yet
+	if l.quota.Limit > 0 && l.quota.Remaining <= 0 && now.Before(l.quota.Reset) {
+		return l.quota.Reset.Sub(now)
+	}
+
+	if l.quota.Budget > 0 {
+		// Drop sends that left the window
+		cutoff := now.Add(-budgetWindow)
+		i := 0
+		for i < len(l.sent) && !l.sent[i].After(cutoff) {
+			i++
+		}
+		l.sent = l.sent[i:]
+
+		if len(l.sent) >= l.quota.Budget {
+			return l.sent[0].Add(budgetWindow).Sub(now)
+		}
+		l.sent = append(l.sent, now)
+	}
+
+	if l.quota.Remaining > 0 {
+		// Count the request until the next response reports the real quota
+		l.quota.Remaining--
+	}
+
+	return 0
+}
@@ -0,0 +1,162 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Resources represents the current resource usage of a server
+type Resources struct {
+	CurrentState string `json:"current_state"`
+	IsSuspended  bool   `json:"is_suspended"`
+	Resources    struct {
+		MemoryBytes    int64   `json:"memory_bytes"`
+		CPUAbsolute    float64 `json:"cpu_absolute"`
+		DiskBytes      int64   `json:"disk_bytes"`
+		NetworkRxBytes int64   `json:"network_rx_bytes"`
+		NetworkTxBytes int64   `json:"network_tx_bytes"`
+		Uptime         int64   `json:"uptime"` // Milliseconds
+	} `json:"resources"`
+}
+
+// ResourcesObject represents a resource usage object in the API response
+type ResourcesObject struct {
+	Object     string    `json:"object"`
+	Attributes Resources `json:"attributes"`
+}
+
+// ServerLimits represents the resource limits of a server. Memory, swap and
+// disk are in MiB and CPU in percent of a core; 0 means unlimited.
+type ServerLimits struct {
+	Memory      int64  `json:"memory"`
+	Swap        int64  `json:"swap"`
+	Disk        int64  `json:"disk"`
+	IO          int64  `json:"io"`
+	CPU         int64  `json:"cpu"`
+	Threads     string `json:"threads"`
+	OOMDisabled bool   `json:"oom_disabled"`
+}
+
+// FeatureLimits represents how many databases, allocations and backups a server may have
+type FeatureLimits struct {
+	Databases   int `json:"databases"`
+	Allocations int `json:"allocations"`
+	Backups     int `json:"backups"`
+}
+
+// SFTPDetails represents the SFTP address of a server
+type SFTPDetails struct {
+	IP   string `json:"ip"`
+	Port int    `json:"port"`
+}
+
+// Egg represents the egg a server was created from
+type Egg struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+// ServerDetails represents the configuration of a server
+type ServerDetails struct {
+	Identifier             string        `json:"identifier"`
+	UUID                   string        `json:"uuid"`
+	Name                   string        `json:"name"`
+	Description            string        `json:"description"`
+	Node                   string        `json:"node"`
+	IsNodeUnderMaintenance bool          `json:"is_node_under_maintenance"`
+	SFTP                   SFTPDetails   `json:"sftp_details"`
+	Limits                 ServerLimits  `json:"limits"`
+	FeatureLimits          FeatureLimits `json:"feature_limits"`
+	DockerImage            string        `json:"docker_image"`
+	Invocation             string        `json:"invocation"`
+	Status                 string        `json:"status"`
+	IsSuspended            bool          `json:"is_suspended"`
+	IsInstalling           bool          `json:"is_installing"`
+	IsTransferring         bool          `json:"is_transferring"`
+	Egg                    Egg           `json:"egg"`
+	Allocations            []Allocation  `json:"allocations"`
+}
+
+// GetResources gets the current state and resource usage of the server
+func (c *Client) GetResources() (*Resources, error) {
+	return c.GetResourcesCtx(context.Background())
+}
+
+// GetResourcesCtx is like GetResources but cancelling ctx aborts the request
+func (c *Client) GetResourcesCtx(ctx context.Context) (*Resources, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/resources", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ResourcesObject{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server resources: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ResourcesObject)
+	return &result.Attributes, nil
+}
+
+// GetServerDetails gets the limits, node, SFTP details, egg and allocations of the server
+func (c *Client) GetServerDetails() (*ServerDetails, error) {
+	return c.GetServerDetailsCtx(context.Background())
+}
+
+// GetServerDetailsCtx is like GetServerDetails but cancelling ctx aborts the request
+func (c *Client) GetServerDetailsCtx(ctx context.Context) (*ServerDetails, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetQueryParam("include", "allocations,egg").
+		SetResult(&ServerObject{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server details: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ServerObject)
+	details := result.Attributes.toServerDetails()
+	return &details, nil
+}
+
+// toServerDetails converts client API server attributes to ServerDetails
+func (sa ServerAttributes) toServerDetails() ServerDetails {
+	details := ServerDetails{
+		Identifier:             sa.Identifier,
+		UUID:                   sa.UUID,
+		Name:                   sa.Name,
+		Description:            sa.Description,
+		Node:                   sa.Node,
+		IsNodeUnderMaintenance: sa.IsNodeUnderMaintenance,
+		SFTP:                   sa.SFTPDetails,
+		Limits:                 sa.Limits,
+		FeatureLimits:          sa.FeatureLimits,
+		DockerImage:            sa.DockerImage,
+		Invocation:             sa.Invocation,
+		Status:                 sa.Status,
+		IsSuspended:            sa.IsSuspended,
+		IsInstalling:           sa.IsInstalling,
+		IsTransferring:         sa.IsTransferring,
+		Egg:                    sa.Relationships.Egg.Attributes,
+		Allocations:            make([]Allocation, len(sa.Relationships.Allocations.Data)),
+	}
+
+	for i, obj := range sa.Relationships.Allocations.Data {
+		details.Allocations[i] = obj.Attributes
+	}
+
+	return details
+}
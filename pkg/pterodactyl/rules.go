@@ -0,0 +1,293 @@
+package pterodactyl
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ValidateRules checks a value against a Laravel-style rules string such as
+// "required|string|max:20". Only the rules used by eggs are understood; unknown
+// rules and regular expressions Go cannot compile are left to the panel.
+func ValidateRules(rules, value string) error {
+	parsed := parseRules(rules)
+
+	if strings.TrimSpace(value) == "" {
+		if _, ok := parsed["required"]; ok {
+			return fmt.Errorf("a value is required")
+		}
+		// Empty optional values skip all other rules
+		return nil
+	}
+
+	// Size rules compare numbers instead of lengths when the value must be numeric
+	_, isInteger := parsed["integer"]
+	_, isNumeric := parsed["numeric"]
+	numeric := isInteger || isNumeric
+
+	for _, r := range splitRules(rules) {
+		name, param := r.name, r.param
+		switch name {
+		case "integer":
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return fmt.Errorf("must be an integer")
+			}
+
+		case "numeric":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("must be a number")
+			}
+
+		case "boolean", "bool":
+			// Variables are sent as strings, for which the panel only accepts "1" and "0"
+			if value != "1" && value != "0" {
+				return fmt.Errorf("must be 1 or 0")
+			}
+
+		case "in":
+			if !containsString(splitParams(param), value) {
+				return fmt.Errorf("must be one of: %s", param)
+			}
+
+		case "not_in":
+			if containsString(splitParams(param), value) {
+				return fmt.Errorf("must not be one of: %s", param)
+			}
+
+		case "min", "max", "size":
+			limit, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				continue
+			}
+			if err := checkSize(name, value, numeric, limit); err != nil {
+				return err
+			}
+
+		case "between":
+			bounds := splitParams(param)
+			if len(bounds) != 2 {
+				continue
+			}
+			lower, errLower := strconv.ParseFloat(bounds[0], 64)
+			upper, errUpper := strconv.ParseFloat(bounds[1], 64)
+			if errLower != nil || errUpper != nil {
+				continue
+			}
+			if err := checkSize("min", value, numeric, lower); err != nil {
+				return err
+			}
+			if err := checkSize("max", value, numeric, upper); err != nil {
+				return err
+			}
+
+		case "digits":
+			length, err := strconv.Atoi(param)
+			if err == nil && (!isDigits(value) || len(value) != length) {
+				return fmt.Errorf("must be %d digits", length)
+			}
+
+		case "digits_between":
+			bounds := splitParams(param)
+			if len(bounds) != 2 {
+				continue
+			}
+			lower, errLower := strconv.Atoi(bounds[0])
+			upper, errUpper := strconv.Atoi(bounds[1])
+			if errLower == nil && errUpper == nil && (!isDigits(value) || len(value) < lower || len(value) > upper) {
+				return fmt.Errorf("must be between %d and %d digits", lower, upper)
+			}
+
+		case "alpha":
+			if !allRunes(value, unicode.IsLetter) {
+				return fmt.Errorf("may only contain letters")
+			}
+
+		case "alpha_num":
+			if !allRunes(value, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) }) {
+				return fmt.Errorf("may only contain letters and numbers")
+			}
+
+		case "alpha_dash":
+			if !allRunes(value, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) || r == '-' || r == '_' }) {
+				return fmt.Errorf("may only contain letters, numbers, dashes and underscores")
+			}
+
+		case "regex", "not_regex":
+			re, err := compilePHPRegex(param)
+			if err != nil {
+				continue
+			}
+			if matched := re.MatchString(value); matched != (name == "regex") {
+				return fmt.Errorf("has an invalid format")
+			}
+
+		case "url":
+			if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("must be a valid URL")
+			}
+
+		case "ip":
+			if net.ParseIP(value) == nil {
+				return fmt.Errorf("must be a valid IP address")
+			}
+
+		case "ipv4":
+			if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+				return fmt.Errorf("must be a valid IPv4 address")
+			}
+
+		case "ipv6":
+			if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+				return fmt.Errorf("must be a valid IPv6 address")
+			}
+		}
+	}
+
+	return nil
+}
+
+// rule represents a single parsed validation rule
+type rule struct {
+	name  string
+	param string
+}
+
+// parseRules returns the rules as a map of name to parameter
+func parseRules(rules string) map[string]string {
+	parsed := make(map[string]string)
+	for _, r := range splitRules(rules) {
+		parsed[r.name] = r.param
+	}
+	return parsed
+}
+
+// splitRules splits a rules string into rules, keeping regular expressions
+// that contain "|" intact
+func splitRules(rules string) []rule {
+	var result []rule
+
+	parts := strings.Split(rules, "|")
+	for i := 0; i < len(parts); i++ {
+		part := strings.TrimSpace(parts[i])
+		if part == "" {
+			continue
+		}
+
+		name, param, _ := strings.Cut(part, ":")
+		name = strings.ToLower(name)
+
+		if name == "regex" || name == "not_regex" {
+			// Re-join the pattern until its closing delimiter is found
+			for !regexClosed(param) && i+1 < len(parts) {
+				i++
+				param += "|" + parts[i]
+			}
+		}
+
+		result = append(result, rule{name: name, param: param})
+	}
+
+	return result
+}
+
+// regexClosed reports whether a PHP regex pattern has its closing delimiter
+func regexClosed(pattern string) bool {
+	if len(pattern) < 2 {
+		return false
+	}
+
+	delim := pattern[0]
+	body := strings.TrimRight(pattern[1:], "imsxuADSUXJ")
+	return len(body) > 0 && body[len(body)-1] == delim && (len(body) < 2 || body[len(body)-2] != '\\')
+}
+
+// compilePHPRegex converts a PHP style "/pattern/flags" regex to a Go regexp
+func compilePHPRegex(pattern string) (*regexp.Regexp, error) {
+	if !regexClosed(pattern) {
+		return nil, fmt.Errorf("unterminated regex %q", pattern)
+	}
+
+	delim := pattern[0]
+	end := strings.LastIndexByte(pattern, delim)
+	body, flags := pattern[1:end], pattern[end+1:]
+
+	prefix := ""
+	for _, f := range flags {
+		switch f {
+		case 'i', 'm', 's':
+			prefix += string(f)
+		}
+	}
+	if prefix != "" {
+		body = "(?" + prefix + ")" + body
+	}
+
+	return regexp.Compile(body)
+}
+
+// checkSize compares a value's number or length against a min, max or size limit
+func checkSize(kind, value string, numeric bool, limit float64) error {
+	var size float64
+	unit := " characters"
+	if numeric {
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil // Reported by the integer or numeric rule
+		}
+		size = n
+		unit = ""
+	} else {
+		size = float64(utf8.RuneCountInString(value))
+	}
+
+	limitStr := strconv.FormatFloat(limit, 'f', -1, 64)
+	switch {
+	case kind == "min" && size < limit:
+		return fmt.Errorf("must be at least %s%s", limitStr, unit)
+	case kind == "max" && size > limit:
+		return fmt.Errorf("must not be greater than %s%s", limitStr, unit)
+	case kind == "size" && size != limit:
+		return fmt.Errorf("must be %s%s", limitStr, unit)
+	}
+
+	return nil
+}
+
+// splitParams splits a comma separated rule parameter
+func splitParams(param string) []string {
+	params := strings.Split(param, ",")
+	for i := range params {
+		params[i] = strings.TrimSpace(params[i])
+	}
+	return params
+}
+
+// containsString reports whether list contains value
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// isDigits reports whether value consists of ASCII digits only
+func isDigits(value string) bool {
+	return value != "" && allRunes(value, func(r rune) bool { return r >= '0' && r <= '9' })
+}
+
+// allRunes reports whether every rune of value satisfies fn
+func allRunes(value string, fn func(rune) bool) bool {
+	for _, r := range value {
+		if !fn(r) {
+			return false
+		}
+	}
+	return true
+}
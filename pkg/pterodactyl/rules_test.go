@@ -0,0 +1,88 @@
+package pterodactyl
+
+import "testing"
+
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   string
+		value   string
+		wantErr bool
+	}{
+		// required and nullable
+		{"required missing", "required|string", "", true},
+		{"required blank", "required|string", "   ", true},
+		{"required present", "required|string", "x", false},
+		{"optional empty skips rules", "nullable|integer|min:5", "", false},
+		{"nullable with value", "nullable|integer", "abc", true},
+
+		// string
+		{"string", "required|string|max:20", "paper", false},
+
+		// numeric and integer
+		{"integer", "required|integer", "42", false},
+		{"integer negative", "required|integer", "-7", false},
+		{"integer rejects decimal", "required|integer", "4.2", true},
+		{"integer rejects text", "required|integer", "abc", true},
+		{"numeric decimal", "required|numeric", "4.2", false},
+		{"numeric rejects text", "required|numeric", "4.2x", true},
+
+		// boolean
+		{"boolean 1", "required|boolean", "1", false},
+		{"boolean 0", "required|boolean", "0", false},
+		{"boolean rejects true", "required|boolean", "true", true},
+		{"boolean rejects false", "required|boolean", "false", true},
+
+		// min, max and between on strings count characters
+		{"string min ok", "required|string|min:3", "abc", false},
+		{"string min too short", "required|string|min:3", "ab", true},
+		{"string max ok", "required|string|max:3", "äöü", false},
+		{"string max too long", "required|string|max:3", "abcd", true},
+		{"string between ok", "required|string|between:2,4", "abc", false},
+		{"string between too long", "required|string|between:2,4", "abcde", true},
+		{"string size", "required|string|size:2", "ab", false},
+
+		// min, max and between on numbers compare values
+		{"number min ok", "required|integer|min:10", "10", false},
+		{"number min too small", "required|integer|min:10", "9", true},
+		{"number max ok", "required|numeric|max:2.5", "2.5", false},
+		{"number max too large", "required|integer|max:100", "101", true},
+		{"number max is not length", "required|integer|max:5", "3", false},
+		{"number between ok", "required|integer|between:1,65535", "25565", false},
+		{"number between too large", "required|integer|between:1,65535", "70000", true},
+
+		// in and not_in
+		{"in ok", "required|string|in:survival,creative,adventure", "creative", false},
+		{"in missing", "required|string|in:survival,creative", "hardcore", true},
+		{"in with spaces", "required|in: a , b ", "b", false},
+		{"not_in ok", "required|not_in:root,admin", "steve", false},
+		{"not_in listed", "required|not_in:root,admin", "root", true},
+
+		// regex
+		{"regex match", "required|regex:/^[a-z]+$/", "abc", false},
+		{"regex mismatch", "required|regex:/^[a-z]+$/", "ABC", true},
+		{"regex case insensitive flag", "required|regex:/^[a-z]+$/i", "ABC", false},
+		{"regex with comma", "required|regex:/^[0-9]{1,3}$/", "123", false},
+		{"regex with comma too long", "required|regex:/^[0-9]{1,3}$/", "1234", true},
+		{"regex with pipe", "required|regex:/^(latest|[0-9.]+)$/|max:20", "latest", false},
+		{"regex with pipe second branch", "required|regex:/^(latest|[0-9.]+)$/|max:20", "1.20.4", false},
+		{"regex with pipe mismatch", "required|regex:/^(latest|[0-9.]+)$/|max:20", "beta", true},
+		{"regex with pipe keeps later rules", "required|regex:/^(latest|[0-9.]+)$/|max:5", "1.20.4", true},
+		{"not_regex", "required|not_regex:/\\s/", "no spaces", true},
+		{"invalid regex left to panel", "required|regex:/(?<=a)b/", "b", false},
+
+		// unknown rules are left to the panel
+		{"unknown rule", "required|string|starts_with:foo", "bar", false},
+		{"unknown rule only", "sometimes", "anything", false},
+		{"empty rules", "", "anything", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRules(tt.rules, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRules(%q, %q) = %v, want error %v", tt.rules, tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
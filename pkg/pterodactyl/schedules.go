@@ -0,0 +1,534 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScheduleCron represents the cron fields of a schedule
+type ScheduleCron struct {
+	Minute     string `json:"minute"`
+	Hour       string `json:"hour"`
+	DayOfMonth string `json:"day_of_month"`
+	Month      string `json:"month"`
+	DayOfWeek  string `json:"day_of_week"`
+}
+
+// Schedule represents a server schedule with its tasks
+type Schedule struct {
+	ID             int          `json:"id"`
+	Name           string       `json:"name"`
+	Cron           ScheduleCron `json:"cron"`
+	IsActive       bool         `json:"is_active"`
+	IsProcessing   bool         `json:"is_processing"`
+	OnlyWhenOnline bool         `json:"only_when_online"`
+	LastRunAt      *time.Time   `json:"last_run_at"`
+	NextRunAt      *time.Time   `json:"next_run_at"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+	Tasks          []Task       `json:"tasks"`
+}
+
+// Task represents a single task of a schedule
+type Task struct {
+	ID                int       `json:"id"`
+	SequenceID        int       `json:"sequence_id"`
+	Action            string    `json:"action"` // command, power or backup
+	Payload           string    `json:"payload"`
+	TimeOffset        int       `json:"time_offset"`
+	IsQueued          bool      `json:"is_queued"`
+	ContinueOnFailure bool      `json:"continue_on_failure"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// TaskObject represents a task object in the API response
+type TaskObject struct {
+	Object     string `json:"object"`
+	Attributes Task   `json:"attributes"`
+}
+
+// ScheduleObject represents a schedule object in the API response
+type ScheduleObject struct {
+	Object     string `json:"object"`
+	Attributes struct {
+		Schedule
+		Relationships struct {
+			Tasks struct {
+				Data []TaskObject `json:"data"`
+			} `json:"tasks"`
+		} `json:"relationships"`
+	} `json:"attributes"`
+}
+
+// ListSchedulesResponse represents the response from the list schedules endpoint
+type ListSchedulesResponse struct {
+	Object string           `json:"object"`
+	Data   []ScheduleObject `json:"data"`
+}
+
+// ScheduleRequest represents a schedule create or update request
+type ScheduleRequest struct {
+	Name           string
+	Cron           ScheduleCron
+	IsActive       bool
+	OnlyWhenOnline bool
+}
+
+// TaskRequest represents a task create or update request
+type TaskRequest struct {
+	Action            string `json:"action"`
+	Payload           string `json:"payload"`
+	TimeOffset        int    `json:"time_offset"`
+	SequenceID        int    `json:"sequence_id,omitempty"`
+	ContinueOnFailure bool   `json:"continue_on_failure"`
+}
+
+// cronField describes the allowed values of a single cron field
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string // Optional names, index matches value minus min
+}
+
+var (
+	cronMonthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	cronDayNames   = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// ParseCron parses a five field cron expression (minute hour day-of-month month day-of-week)
+func ParseCron(expr string) (ScheduleCron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return ScheduleCron{}, fmt.Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+
+	cron := ScheduleCron{
+		Minute:     fields[0],
+		Hour:       fields[1],
+		DayOfMonth: fields[2],
+		Month:      fields[3],
+		DayOfWeek:  fields[4],
+	}
+
+	if err := cron.Validate(); err != nil {
+		return ScheduleCron{}, err
+	}
+
+	return cron, nil
+}
+
+// String returns the cron fields as a single expression
+func (sc ScheduleCron) String() string {
+	return strings.Join([]string{sc.Minute, sc.Hour, sc.DayOfMonth, sc.Month, sc.DayOfWeek}, " ")
+}
+
+// Validate checks that every cron field is a valid cron expression
+func (sc ScheduleCron) Validate() error {
+	checks := []struct {
+		value string
+		field cronField
+	}{
+		{sc.Minute, cronField{name: "minute", min: 0, max: 59}},
+		{sc.Hour, cronField{name: "hour", min: 0, max: 23}},
+		{sc.DayOfMonth, cronField{name: "day of month", min: 1, max: 31}},
+		{sc.Month, cronField{name: "month", min: 1, max: 12, names: cronMonthNames}},
+		{sc.DayOfWeek, cronField{name: "day of week", min: 0, max: 7, names: cronDayNames}},
+	}
+
+	for _, check := range checks {
+		if err := check.field.validate(check.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks a single cron field, supporting lists, ranges and steps
+func (f cronField) validate(value string) error {
+	if value == "" {
+		return fmt.Errorf("invalid cron %s: value is empty", f.name)
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		rangePart := part
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			rangePart = part[:idx]
+			step, err := strconv.Atoi(part[idx+1:])
+			if err != nil || step < 1 {
+				return fmt.Errorf("invalid cron %s: bad step in %q", f.name, part)
+			}
+		}
+
+		if rangePart == "*" {
+			continue
+		}
+
+		bounds := strings.SplitN(rangePart, "-", 2)
+		start, err := f.parseValue(bounds[0])
+		if err != nil {
+			return fmt.Errorf("invalid cron %s: %w", f.name, err)
+		}
+		if len(bounds) == 2 {
+			end, err := f.parseValue(bounds[1])
+			if err != nil {
+				return fmt.Errorf("invalid cron %s: %w", f.name, err)
+			}
+			if end < start {
+				return fmt.Errorf("invalid cron %s: range %q is reversed", f.name, rangePart)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseValue parses a numeric or named cron value and checks its bounds
+func (f cronField) parseValue(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%d is outside %d-%d", n, f.min, f.max)
+	}
+
+	return n, nil
+}
+
+// toSchedule converts a schedule object to a Schedule with its tasks
+func (so ScheduleObject) toSchedule() Schedule {
+	schedule := so.Attributes.Schedule
+	schedule.Tasks = make([]Task, len(so.Attributes.Relationships.Tasks.Data))
+	for i, obj := range so.Attributes.Relationships.Tasks.Data {
+		schedule.Tasks[i] = obj.Attributes
+	}
+	return schedule
+}
+
+// scheduleBody builds the request body for creating or updating a schedule
+func scheduleBody(req ScheduleRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"name":             req.Name,
+		"minute":           req.Cron.Minute,
+		"hour":             req.Cron.Hour,
+		"day_of_month":     req.Cron.DayOfMonth,
+		"month":            req.Cron.Month,
+		"day_of_week":      req.Cron.DayOfWeek,
+		"is_active":        req.IsActive,
+		"only_when_online": req.OnlyWhenOnline,
+	}
+}
+
+// ListSchedules lists all schedules of the server including their tasks
+func (c *Client) ListSchedules() ([]Schedule, error) {
+	return c.ListSchedulesCtx(context.Background())
+}
+
+// ListSchedulesCtx is like ListSchedules but cancelling ctx aborts the request
+func (c *Client) ListSchedulesCtx(ctx context.Context) ([]Schedule, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ListSchedulesResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListSchedulesResponse)
+
+	schedules := make([]Schedule, len(result.Data))
+	for i, obj := range result.Data {
+		schedules[i] = obj.toSchedule()
+	}
+
+	return schedules, nil
+}
+
+// GetSchedule gets a single schedule including its tasks
+func (c *Client) GetSchedule(scheduleID int) (*Schedule, error) {
+	return c.GetScheduleCtx(context.Background(), scheduleID)
+}
+
+// GetScheduleCtx is like GetSchedule but cancelling ctx aborts the request
+func (c *Client) GetScheduleCtx(ctx context.Context, scheduleID int) (*Schedule, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules/%d", c.baseURL, c.serverID, scheduleID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ScheduleObject{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	schedule := resp.Result().(*ScheduleObject).toSchedule()
+	return &schedule, nil
+}
+
+// CreateSchedule creates a new schedule
+func (c *Client) CreateSchedule(req ScheduleRequest) (*Schedule, error) {
+	return c.CreateScheduleCtx(context.Background(), req)
+}
+
+// CreateScheduleCtx is like CreateSchedule but cancelling ctx aborts the request
+func (c *Client) CreateScheduleCtx(ctx context.Context, req ScheduleRequest) (*Schedule, error) {
+	if err := req.Cron.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(scheduleBody(req)).
+		SetResult(&ScheduleObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	schedule := resp.Result().(*ScheduleObject).toSchedule()
+	return &schedule, nil
+}
+
+// UpdateSchedule updates an existing schedule
+func (c *Client) UpdateSchedule(scheduleID int, req ScheduleRequest) (*Schedule, error) {
+	return c.UpdateScheduleCtx(context.Background(), scheduleID, req)
+}
+
+// UpdateScheduleCtx is like UpdateSchedule but cancelling ctx aborts the request
+func (c *Client) UpdateScheduleCtx(ctx context.Context, scheduleID int, req ScheduleRequest) (*Schedule, error) {
+	if err := req.Cron.Validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules/%d", c.baseURL, c.serverID, scheduleID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(scheduleBody(req)).
+		SetResult(&ScheduleObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	schedule := resp.Result().(*ScheduleObject).toSchedule()
+	return &schedule, nil
+}
+
+// DeleteSchedule deletes a schedule and all of its tasks
+func (c *Client) DeleteSchedule(scheduleID int) error {
+	return c.DeleteScheduleCtx(context.Background(), scheduleID)
+}
+
+// DeleteScheduleCtx is like DeleteSchedule but cancelling ctx aborts the request
+func (c *Client) DeleteScheduleCtx(ctx context.Context, scheduleID int) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules/%d", c.baseURL, c.serverID, scheduleID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Delete(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// ExecuteSchedule triggers a schedule to run immediately
+func (c *Client) ExecuteSchedule(scheduleID int) error {
+	return c.ExecuteScheduleCtx(context.Background(), scheduleID)
+}
+
+// ExecuteScheduleCtx is like ExecuteSchedule but cancelling ctx aborts the request
+func (c *Client) ExecuteScheduleCtx(ctx context.Context, scheduleID int) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules/%d/execute", c.baseURL, c.serverID, scheduleID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Post(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to execute schedule: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusAccepted && resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// CreateTask adds a task to a schedule
+func (c *Client) CreateTask(scheduleID int, req TaskRequest) (*Task, error) {
+	return c.CreateTaskCtx(context.Background(), scheduleID, req)
+}
+
+// CreateTaskCtx is like CreateTask but cancelling ctx aborts the request
+func (c *Client) CreateTaskCtx(ctx context.Context, scheduleID int, req TaskRequest) (*Task, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules/%d/tasks", c.baseURL, c.serverID, scheduleID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&TaskObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*TaskObject)
+	return &result.Attributes, nil
+}
+
+// UpdateTask updates a task of a schedule
+func (c *Client) UpdateTask(scheduleID, taskID int, req TaskRequest) (*Task, error) {
+	return c.UpdateTaskCtx(context.Background(), scheduleID, taskID, req)
+}
+
+// UpdateTaskCtx is like UpdateTask but cancelling ctx aborts the request
+func (c *Client) UpdateTaskCtx(ctx context.Context, scheduleID, taskID int, req TaskRequest) (*Task, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules/%d/tasks/%d", c.baseURL, c.serverID, scheduleID, taskID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&TaskObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*TaskObject)
+	return &result.Attributes, nil
+}
+
+// DeleteTask removes a task from a schedule
+func (c *Client) DeleteTask(scheduleID, taskID int) error {
+	return c.DeleteTaskCtx(context.Background(), scheduleID, taskID)
+}
+
+// DeleteTaskCtx is like DeleteTask but cancelling ctx aborts the request
+func (c *Client) DeleteTaskCtx(ctx context.Context, scheduleID, taskID int) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/schedules/%d/tasks/%d", c.baseURL, c.serverID, scheduleID, taskID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Delete(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
+
+// ReorderTasks changes the execution order of a schedule's tasks
+// taskIDs must contain every task of the schedule in the desired order
+func (c *Client) ReorderTasks(scheduleID int, taskIDs []int) error {
+	return c.ReorderTasksCtx(context.Background(), scheduleID, taskIDs)
+}
+
+// ReorderTasksCtx is like ReorderTasks but cancelling ctx aborts the requests
+func (c *Client) ReorderTasksCtx(ctx context.Context, scheduleID int, taskIDs []int) error {
+	schedule, err := c.GetScheduleCtx(ctx, scheduleID)
+	if err != nil {
+		return err
+	}
+
+	tasks := make(map[int]Task, len(schedule.Tasks))
+	for _, t := range schedule.Tasks {
+		tasks[t.ID] = t
+	}
+
+	if len(taskIDs) != len(tasks) {
+		return fmt.Errorf("expected %d task IDs, got %d", len(tasks), len(taskIDs))
+	}
+
+	// Check the whole order first so a bad ID can't leave the schedule half reordered
+	seen := make(map[int]bool, len(taskIDs))
+	for _, id := range taskIDs {
+		if _, ok := tasks[id]; !ok {
+			return fmt.Errorf("task %d does not belong to schedule %d", id, scheduleID)
+		}
+		if seen[id] {
+			return fmt.Errorf("task %d is listed twice", id)
+		}
+		seen[id] = true
+	}
+
+	// The panel shifts the other tasks when a sequence changes, so moving each
+	// task into place from first to last produces the requested order
+	for i, id := range taskIDs {
+		task := tasks[id]
+		req := TaskRequest{
+			Action:            task.Action,
+			Payload:           task.Payload,
+			TimeOffset:        task.TimeOffset,
+			SequenceID:        i + 1,
+			ContinueOnFailure: task.ContinueOnFailure,
+		}
+
+		if _, err := c.UpdateTaskCtx(ctx, scheduleID, id, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package pterodactyl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{"every minute", "* * * * *", false},
+		{"fixed time", "30 4 1 1 0", false},
+		{"extra whitespace", "  0   */6  * *   * ", false},
+
+		// Steps
+		{"step on wildcard", "*/5 * * * *", false},
+		{"step on range", "1-10/2 * * * *", false},
+		{"step on single value", "5/15 * * * *", false},
+		{"zero step", "*/0 * * * *", true},
+		{"negative step", "*/-1 * * * *", true},
+		{"missing step", "*/ * * * *", true},
+		{"non-numeric step", "*/x * * * *", true},
+
+		// Lists
+		{"list", "0,15,30,45 * * * *", false},
+		{"list of ranges and steps", "0-10/5,30,50-59 * * * *", false},
+		{"empty list item", "0,,30 * * * *", true},
+		{"list item out of range", "0,60 * * * *", true},
+
+		// Ranges
+		{"range", "0 9-17 * * *", false},
+		{"reversed range", "0 17-9 * * *", true},
+		{"range end out of bounds", "0 20-24 * * *", true},
+		{"range start out of bounds", "0 * 0-5 * *", true},
+		{"open range", "0 9- * * *", true},
+		{"triple range", "0 1-2-3 * * *", true},
+
+		// Field bounds
+		{"minute 59", "59 * * * *", false},
+		{"minute 60", "60 * * * *", true},
+		{"hour 24", "0 24 * * *", true},
+		{"day of month 0", "0 0 0 * *", true},
+		{"day of month 31", "0 0 31 * *", false},
+		{"month 13", "0 0 1 13 *", true},
+		{"month names", "0 0 1 JAN-MAR *", false},
+		{"unknown month name", "0 0 1 FOO *", true},
+
+		// Day of week 0-7, both 0 and 7 are Sunday
+		{"day of week 0", "0 0 * * 0", false},
+		{"day of week 7", "0 0 * * 7", false},
+		{"day of week 8", "0 0 * * 8", true},
+		{"day of week range to 7", "0 0 * * 5-7", false},
+		{"day of week names", "0 0 * * mon-fri", false},
+		{"day of week reversed names", "0 0 * * FRI-MON", true},
+
+		// Field counts
+		{"empty", "", true},
+		{"four fields", "* * * *", true},
+		{"six fields", "0 * * * * *", true},
+		{"non-numeric value", "a * * * *", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCron(%q) error = %v, want error %v", tt.expr, err, tt.wantErr)
+			}
+			if err == nil {
+				if vErr := cron.Validate(); vErr != nil {
+					t.Errorf("Validate() of parsed %q = %v", tt.expr, vErr)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleCronValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cron    ScheduleCron
+		wantErr bool
+	}{
+		{"valid", ScheduleCron{Minute: "*/5", Hour: "*", DayOfMonth: "*", Month: "*", DayOfWeek: "*"}, false},
+		{"empty field", ScheduleCron{Minute: "", Hour: "*", DayOfMonth: "*", Month: "*", DayOfWeek: "*"}, true},
+		{"zero value", ScheduleCron{}, true},
+		{"bad day of week", ScheduleCron{Minute: "0", Hour: "0", DayOfMonth: "*", Month: "*", DayOfWeek: "9"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cron.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScheduleCronString(t *testing.T) {
+	cron, err := ParseCron(" */5  0 * *  1-5 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := cron.String(), "*/5 0 * * 1-5"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestReorderTasksRejectsBadOrderBeforeUpdating(t *testing.T) {
+	var updates atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/schedules/1"):
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"object":"server_schedule","attributes":{"id":1,"relationships":{"tasks":{"data":[
+				{"object":"schedule_task","attributes":{"id":10,"sequence_id":1}},
+				{"object":"schedule_task","attributes":{"id":11,"sequence_id":2}},
+				{"object":"schedule_task","attributes":{"id":12,"sequence_id":3}}
+			]}}}}`))
+		case r.Method == http.MethodPost:
+			updates.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"object":"schedule_task","attributes":{}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, "key", "abc123")
+	defer c.Close()
+
+	tests := []struct {
+		name    string
+		taskIDs []int
+	}{
+		{"unknown last", []int{12, 11, 99}},
+		{"duplicate last", []int{12, 11, 11}},
+		{"wrong count", []int{12, 11}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates.Store(0)
+			if err := c.ReorderTasks(1, tt.taskIDs); err == nil {
+				t.Fatal("ReorderTasks() succeeded, want error")
+			}
+			if got := updates.Load(); got != 0 {
+				t.Errorf("sent %d task updates before rejecting the order", got)
+			}
+		})
+	}
+
+	updates.Store(0)
+	if err := c.ReorderTasks(1, []int{12, 10, 11}); err != nil {
+		t.Fatalf("ReorderTasks() = %v", err)
+	}
+	if got := updates.Load(); got != 3 {
+		t.Errorf("sent %d task updates, want 3", got)
+	}
+}
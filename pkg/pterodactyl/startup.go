@@ -0,0 +1,177 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// StartupVariable represents an egg variable of a server
+type StartupVariable struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	EnvVariable  string `json:"env_variable"`
+	DefaultValue string `json:"default_value"`
+	ServerValue  string `json:"server_value"`
+	IsEditable   bool   `json:"is_editable"`
+	Rules        string `json:"rules"` // Laravel validation rules, e.g. "required|string|max:20"
+}
+
+// StartupVariableObject represents a startup variable object in the API response
+type StartupVariableObject struct {
+	Object     string          `json:"object"`
+	Attributes StartupVariable `json:"attributes"`
+}
+
+// StartupResponse represents the response from the startup endpoint
+type StartupResponse struct {
+	Object string                  `json:"object"`
+	Data   []StartupVariableObject `json:"data"`
+	Meta   struct {
+		StartupCommand    string            `json:"startup_command"`
+		RawStartupCommand string            `json:"raw_startup_command"`
+		DockerImages      map[string]string `json:"docker_images"`
+	} `json:"meta"`
+}
+
+// Startup represents the startup configuration of a server
+type Startup struct {
+	StartupCommand    string
+	RawStartupCommand string
+	DockerImages      map[string]string // Display name to image
+	Variables         []StartupVariable
+}
+
+// Validate checks whether value can be assigned to the variable
+func (sv StartupVariable) Validate(value string) error {
+	if !sv.IsEditable {
+		return fmt.Errorf("variable %s is not editable", sv.EnvVariable)
+	}
+
+	if err := ValidateRules(sv.Rules, value); err != nil {
+		return fmt.Errorf("invalid value for %s: %w", sv.EnvVariable, err)
+	}
+
+	return nil
+}
+
+// GetStartup gets the startup command, docker images and variables of the server
+func (c *Client) GetStartup() (*Startup, error) {
+	return c.GetStartupCtx(context.Background())
+}
+
+// GetStartupCtx is like GetStartup but cancelling ctx aborts the request
+func (c *Client) GetStartupCtx(ctx context.Context) (*Startup, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/startup", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&StartupResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get startup: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*StartupResponse)
+
+	startup := &Startup{
+		StartupCommand:    result.Meta.StartupCommand,
+		RawStartupCommand: result.Meta.RawStartupCommand,
+		DockerImages:      result.Meta.DockerImages,
+		Variables:         make([]StartupVariable, len(result.Data)),
+	}
+	for i, obj := range result.Data {
+		startup.Variables[i] = obj.Attributes
+	}
+
+	return startup, nil
+}
+
+// UpdateStartupVariable sets the value of a startup variable
+// The value is validated against the variable's rules before it is sent
+func (c *Client) UpdateStartupVariable(key, value string) (*StartupVariable, error) {
+	return c.UpdateStartupVariableCtx(context.Background(), key, value)
+}
+
+// UpdateStartupVariableCtx is like UpdateStartupVariable but cancelling ctx aborts the requests
+func (c *Client) UpdateStartupVariableCtx(ctx context.Context, key, value string) (*StartupVariable, error) {
+	startup, err := c.GetStartupCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var variable *StartupVariable
+	for i := range startup.Variables {
+		if startup.Variables[i].EnvVariable == key {
+			variable = &startup.Variables[i]
+			break
+		}
+	}
+
+	if variable == nil {
+		return nil, fmt.Errorf("startup variable %s not found", key)
+	}
+
+	if err := variable.Validate(value); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/startup/variable", c.baseURL, c.serverID)
+
+	body := map[string]string{
+		"key":   key,
+		"value": value,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		SetResult(&StartupVariableObject{}).
+		Put(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update startup variable: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*StartupVariableObject)
+	return &result.Attributes, nil
+}
+
+// SetDockerImage changes the docker image of the server
+// The image must be one of the images offered by the server's egg
+func (c *Client) SetDockerImage(image string) error {
+	return c.SetDockerImageCtx(context.Background(), image)
+}
+
+// SetDockerImageCtx is like SetDockerImage but cancelling ctx aborts the request
+func (c *Client) SetDockerImageCtx(ctx context.Context, image string) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/settings/docker-image", c.baseURL, c.serverID)
+
+	body := map[string]string{
+		"docker_image": image,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		Put(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to set docker image: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package pterodactyl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Subuser represents a user with limited access to a server
+type Subuser struct {
+	UUID        string    `json:"uuid"`
+	Username    string    `json:"username"`
+	Email       string    `json:"email"`
+	Image       string    `json:"image"`
+	TwoFactor   bool      `json:"2fa_enabled"`
+	CreatedAt   time.Time `json:"created_at"`
+	Permissions []string  `json:"permissions"`
+}
+
+// SubuserObject represents a subuser object in the API response
+type SubuserObject struct {
+	Object     string  `json:"object"`
+	Attributes Subuser `json:"attributes"`
+}
+
+// ListSubusersResponse represents the response from the list subusers endpoint
+type ListSubusersResponse struct {
+	Object string          `json:"object"`
+	Data   []SubuserObject `json:"data"`
+}
+
+// Permission represents a single permission key that can be granted to a subuser
+type Permission struct {
+	Key         string `json:"key"` // Full key, e.g. "control.console"
+	Group       string `json:"group"`
+	Description string `json:"description"`
+}
+
+// PermissionsResponse represents the response from the permissions endpoint
+type PermissionsResponse struct {
+	Object     string `json:"object"`
+	Attributes struct {
+		Permissions map[string]struct {
+			Description string            `json:"description"`
+			Keys        map[string]string `json:"keys"`
+		} `json:"permissions"`
+	} `json:"attributes"`
+}
+
+// GetPermissions lists all permission keys known to the panel
+func (c *Client) GetPermissions() ([]Permission, error) {
+	return c.GetPermissionsCtx(context.Background())
+}
+
+// GetPermissionsCtx is like GetPermissions but cancelling ctx aborts the request
+func (c *Client) GetPermissionsCtx(ctx context.Context) ([]Permission, error) {
+	endpoint := fmt.Sprintf("%s/api/client/permissions", c.baseURL)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&PermissionsResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permissions: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*PermissionsResponse)
+
+	var permissions []Permission
+	for group, def := range result.Attributes.Permissions {
+		for key, description := range def.Keys {
+			permissions = append(permissions, Permission{
+				Key:         group + "." + key,
+				Group:       group,
+				Description: description,
+			})
+		}
+	}
+
+	// Map iteration order is random, keep the list stable for the UI
+	sort.Slice(permissions, func(i, j int) bool {
+		return permissions[i].Key < permissions[j].Key
+	})
+
+	return permissions, nil
+}
+
+// ListSubusers lists all subusers of the server
+func (c *Client) ListSubusers() ([]Subuser, error) {
+	return c.ListSubusersCtx(context.Background())
+}
+
+// ListSubusersCtx is like ListSubusers but cancelling ctx aborts the request
+func (c *Client) ListSubusersCtx(ctx context.Context) ([]Subuser, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/users", c.baseURL, c.serverID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&ListSubusersResponse{}).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subusers: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*ListSubusersResponse)
+
+	subusers := make([]Subuser, len(result.Data))
+	for i, obj := range result.Data {
+		subusers[i] = obj.Attributes
+	}
+
+	return subusers, nil
+}
+
+// InviteSubuser gives a user access to the server, creating the account if needed
+func (c *Client) InviteSubuser(email string, permissions []string) (*Subuser, error) {
+	return c.InviteSubuserCtx(context.Background(), email, permissions)
+}
+
+// InviteSubuserCtx is like InviteSubuser but cancelling ctx aborts the request
+func (c *Client) InviteSubuserCtx(ctx context.Context, email string, permissions []string) (*Subuser, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/users", c.baseURL, c.serverID)
+
+	body := map[string]interface{}{
+		"email":       email,
+		"permissions": permissions,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		SetResult(&SubuserObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite subuser: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*SubuserObject)
+	return &result.Attributes, nil
+}
+
+// UpdateSubuserPermissions replaces the permissions of a subuser
+func (c *Client) UpdateSubuserPermissions(userUUID string, permissions []string) (*Subuser, error) {
+	return c.UpdateSubuserPermissionsCtx(context.Background(), userUUID, permissions)
+}
+
+// UpdateSubuserPermissionsCtx is like UpdateSubuserPermissions but cancelling ctx aborts the request
+func (c *Client) UpdateSubuserPermissionsCtx(ctx context.Context, userUUID string, permissions []string) (*Subuser, error) {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/users/%s", c.baseURL, c.serverID, userUUID)
+
+	body := map[string]interface{}{
+		"permissions": permissions,
+	}
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(body).
+		SetResult(&SubuserObject{}).
+		Post(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to update subuser: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	result := resp.Result().(*SubuserObject)
+	return &result.Attributes, nil
+}
+
+// RemoveSubuser revokes a subuser's access to the server
+func (c *Client) RemoveSubuser(userUUID string) error {
+	return c.RemoveSubuserCtx(context.Background(), userUUID)
+}
+
+// RemoveSubuserCtx is like RemoveSubuser but cancelling ctx aborts the request
+func (c *Client) RemoveSubuserCtx(ctx context.Context, userUUID string) error {
+	endpoint := fmt.Sprintf("%s/api/client/servers/%s/users/%s", c.baseURL, c.serverID, userUUID)
+
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Delete(endpoint)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove subuser: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return newAPIError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,515 @@
+package pterodactyl
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SyncMode selects the direction of a folder sync
+type SyncMode string
+
+const (
+	// SyncPush uploads local files that are new or changed
+	SyncPush SyncMode = "push"
+	// SyncPull downloads remote files that are new or changed
+	SyncPull SyncMode = "pull"
+	// SyncMirror copies in both directions. With the state of the previous sync the
+	// side that changed since wins; without it, the newer side wins.
+	SyncMirror SyncMode = "mirror"
+)
+
+// SyncAction is a single planned sync operation
+type SyncAction string
+
+const (
+	SyncUpload       SyncAction = "upload"
+	SyncDownload     SyncAction = "download"
+	SyncDeleteRemote SyncAction = "delete-remote"
+	SyncDeleteLocal  SyncAction = "delete-local"
+	SyncConflict     SyncAction = "conflict" // Reported only, never executed
+)
+
+// syncTimeTolerance absorbs timestamp precision differences between filesystems
+const syncTimeTolerance = 2 * time.Second
+
+// SyncOptions configures a folder sync
+type SyncOptions struct {
+	Mode        SyncMode
+	Delete      bool       // Delete files missing on the source side; in mirror mode, files deleted on one side since State
+	Hash        bool       // Compare contents by SHA-256 when sizes match but times differ
+	DryRun      bool       // Plan only, change nothing
+	Exclude     []string   // Glob patterns matched against each path element, e.g. ".git"
+	State       *SyncState // Mirror only: both sides after the previous sync, nil for the first one
+	Parallelism int
+}
+
+// SyncState records both sides of every file after a mirror sync, keyed by
+// relative path. The next mirror sync uses it to tell which side changed,
+// since uploading gives the remote file a newer time than the local one, and
+// to tell a deleted file from a new one.
+type SyncState struct {
+	Files map[string]SyncStateFile `json:"files"`
+}
+
+// SyncStateFile is a file as it was on both sides after a mirror sync
+type SyncStateFile struct {
+	LocalSize        int64     `json:"local_size"`
+	LocalModifiedAt  time.Time `json:"local_modified_at"`
+	RemoteSize       int64     `json:"remote_size"`
+	RemoteModifiedAt time.Time `json:"remote_modified_at"`
+}
+
+// file returns the recorded state of a file, if s holds one
+func (s *SyncState) file(p string) (SyncStateFile, bool) {
+	if s == nil {
+		return SyncStateFile{}, false
+	}
+	f, ok := s.Files[p]
+	return f, ok
+}
+
+// SyncItem is a planned operation on a single file
+type SyncItem struct {
+	Path   string     `json:"path"` // Relative, slash separated path
+	Action SyncAction `json:"action"`
+	Size   int64      `json:"size"`
+	Reason string     `json:"reason"`
+}
+
+// SyncResult is the outcome of a planned operation
+type SyncResult struct {
+	SyncItem
+	Error string `json:"error,omitempty"`
+}
+
+// SyncReport describes a planned or executed sync
+type SyncReport struct {
+	Mode      SyncMode     `json:"mode"`
+	LocalDir  string       `json:"localDir"`
+	RemoteDir string       `json:"remoteDir"`
+	DryRun    bool         `json:"dryRun"`
+	Results   []SyncResult `json:"results"`
+	State     *SyncState   `json:"-"` // Set after an executed mirror sync, to pass to the next one
+}
+
+// syncEntry is a file on either side of a sync
+type syncEntry struct {
+	size       int64
+	modifiedAt time.Time
+}
+
+// unchangedSince reports whether the file still has the given size and time
+func (e syncEntry) unchangedSince(size int64, modifiedAt time.Time) bool {
+	diff := e.modifiedAt.Sub(modifiedAt)
+	return e.size == size && diff <= syncTimeTolerance && diff >= -syncTimeTolerance
+}
+
+// SyncCtx compares localDir with remoteDir, plans the operations the mode requires
+// and, unless DryRun is set, executes them. Per-file failures are reported in the
+// results; the error is only set when the trees could not be compared.
+func (c *Client) SyncCtx(ctx context.Context, localDir, remoteDir string, opts SyncOptions) (*SyncReport, error) {
+	switch opts.Mode {
+	case SyncPush, SyncPull, SyncMirror:
+	default:
+		return nil, fmt.Errorf("unknown sync mode: %s", opts.Mode)
+	}
+	local, err := listLocalTree(localDir, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := make(map[string]syncEntry)
+	if err := c.listRemoteTree(ctx, remoteDir, "", opts.Exclude, remote); err != nil {
+		return nil, err
+	}
+
+	items, err := c.planSync(ctx, localDir, remoteDir, local, remote, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SyncReport{
+		Mode:      opts.Mode,
+		LocalDir:  localDir,
+		RemoteDir: remoteDir,
+		DryRun:    opts.DryRun,
+		Results:   make([]SyncResult, len(items)),
+	}
+	for i, item := range items {
+		report.Results[i] = SyncResult{SyncItem: item}
+	}
+
+	if !opts.DryRun {
+		c.executeSync(ctx, localDir, remoteDir, remote, report.Results, opts.Parallelism)
+		if opts.Mode == SyncMirror && ctx.Err() == nil {
+			report.State = c.syncState(ctx, localDir, remoteDir, opts, report.Results)
+		}
+	}
+
+	return report, nil
+}
+
+// syncState records both sides after an executed mirror sync. Files whose
+// operation failed or was a conflict keep their previous state, so the next
+// sync sees them as changed again. It returns nil if the trees can't be listed.
+func (c *Client) syncState(ctx context.Context, localDir, remoteDir string, opts SyncOptions, results []SyncResult) *SyncState {
+	local, err := listLocalTree(localDir, opts.Exclude)
+	if err != nil {
+		return nil
+	}
+	remote := make(map[string]syncEntry)
+	if err := c.listRemoteTree(ctx, remoteDir, "", opts.Exclude, remote); err != nil {
+		return nil
+	}
+
+	unsettled := make(map[string]bool)
+	for _, r := range results {
+		if r.Error != "" || r.Action == SyncConflict {
+			unsettled[r.Path] = true
+		}
+	}
+
+	state := &SyncState{Files: make(map[string]SyncStateFile, len(local))}
+	for p, l := range local {
+		if unsettled[p] {
+			if prev, ok := opts.State.file(p); ok {
+				state.Files[p] = prev
+			}
+			continue
+		}
+		if r, ok := remote[p]; ok {
+			state.Files[p] = SyncStateFile{
+				LocalSize:        l.size,
+				LocalModifiedAt:  l.modifiedAt,
+				RemoteSize:       r.size,
+				RemoteModifiedAt: r.modifiedAt,
+			}
+		}
+	}
+
+	return state
+}
+
+// planSync decides which operations bring the two trees in line
+func (c *Client) planSync(ctx context.Context, localDir, remoteDir string, local, remote map[string]syncEntry, opts SyncOptions) ([]SyncItem, error) {
+	paths := make(map[string]bool, len(local)+len(remote))
+	for p := range local {
+		paths[p] = true
+	}
+	for p := range remote {
+		paths[p] = true
+	}
+
+	sorted := make([]string, 0, len(paths))
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	sort.Strings(sorted)
+
+	var items []SyncItem
+	for _, p := range sorted {
+		l, inLocal := local[p]
+		r, inRemote := remote[p]
+		prev, synced := opts.State.file(p)
+		mirrorDelete := opts.Mode == SyncMirror && opts.Delete && synced
+
+		switch {
+		case inLocal && !inRemote:
+			switch {
+			case mirrorDelete && l.unchangedSince(prev.LocalSize, prev.LocalModifiedAt):
+				items = append(items, SyncItem{Path: p, Action: SyncDeleteLocal, Size: l.size, Reason: "deleted on the server since the last sync"})
+			case opts.Mode != SyncPull:
+				items = append(items, SyncItem{Path: p, Action: SyncUpload, Size: l.size, Reason: "only exists locally"})
+			case opts.Delete:
+				items = append(items, SyncItem{Path: p, Action: SyncDeleteLocal, Size: l.size, Reason: "does not exist on the server"})
+			}
+
+		case inRemote && !inLocal:
+			switch {
+			case mirrorDelete && r.unchangedSince(prev.RemoteSize, prev.RemoteModifiedAt):
+				items = append(items, SyncItem{Path: p, Action: SyncDeleteRemote, Size: r.size, Reason: "deleted locally since the last sync"})
+			case opts.Mode != SyncPush:
+				items = append(items, SyncItem{Path: p, Action: SyncDownload, Size: r.size, Reason: "only exists on the server"})
+			case opts.Delete:
+				items = append(items, SyncItem{Path: p, Action: SyncDeleteRemote, Size: r.size, Reason: "does not exist locally"})
+			}
+
+		default:
+			item, err := c.compareSyncEntries(ctx, localDir, remoteDir, p, l, r, opts)
+			if err != nil {
+				return nil, err
+			}
+			if item != nil {
+				items = append(items, *item)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// compareSyncEntries plans the operation for a file that exists on both sides, if any
+func (c *Client) compareSyncEntries(ctx context.Context, localDir, remoteDir, p string, l, r syncEntry, opts SyncOptions) (*SyncItem, error) {
+	if prev, ok := opts.State.file(p); ok && opts.Mode == SyncMirror {
+		return c.compareSinceLastSync(ctx, localDir, remoteDir, p, l, r, prev, opts)
+	}
+
+	diff := l.modifiedAt.Sub(r.modifiedAt)
+	localNewer := diff > syncTimeTolerance
+	remoteNewer := diff < -syncTimeTolerance
+
+	// Uploaded files get a new remote time, so a push only cares about newer local
+	// files and a pull only about newer remote files
+	var timeChanged bool
+	switch opts.Mode {
+	case SyncPush:
+		timeChanged = localNewer
+	case SyncPull:
+		timeChanged = remoteNewer
+	case SyncMirror:
+		timeChanged = localNewer || remoteNewer
+	}
+
+	reason := "size differs"
+	if l.size == r.size {
+		if !timeChanged {
+			return nil, nil
+		}
+
+		reason = "modification time differs"
+		if opts.Hash {
+			same, err := c.SameContentCtx(ctx, filepath.Join(localDir, filepath.FromSlash(p)), path.Join(remoteDir, p))
+			if err != nil {
+				return nil, err
+			}
+			if same {
+				return nil, nil
+			}
+			reason = "content differs"
+		}
+	}
+
+	switch opts.Mode {
+	case SyncPush:
+		return &SyncItem{Path: p, Action: SyncUpload, Size: l.size, Reason: reason}, nil
+	case SyncPull:
+		return &SyncItem{Path: p, Action: SyncDownload, Size: r.size, Reason: reason}, nil
+	}
+
+	switch {
+	case localNewer:
+		return &SyncItem{Path: p, Action: SyncUpload, Size: l.size, Reason: reason + ", newer locally"}, nil
+	case remoteNewer:
+		return &SyncItem{Path: p, Action: SyncDownload, Size: r.size, Reason: reason + ", newer on the server"}, nil
+	default:
+		return &SyncItem{Path: p, Action: SyncConflict, Size: l.size, Reason: reason + " but both sides have the same time"}, nil
+	}
+}
+
+// compareSinceLastSync plans a mirror operation for a file that exists on both
+// sides by which side changed since the previous sync
+func (c *Client) compareSinceLastSync(ctx context.Context, localDir, remoteDir, p string, l, r syncEntry, prev SyncStateFile, opts SyncOptions) (*SyncItem, error) {
+	localChanged := !l.unchangedSince(prev.LocalSize, prev.LocalModifiedAt)
+	remoteChanged := !r.unchangedSince(prev.RemoteSize, prev.RemoteModifiedAt)
+
+	switch {
+	case !localChanged && !remoteChanged:
+		return nil, nil
+	case !remoteChanged:
+		return &SyncItem{Path: p, Action: SyncUpload, Size: l.size, Reason: "changed locally since the last sync"}, nil
+	case !localChanged:
+		return &SyncItem{Path: p, Action: SyncDownload, Size: r.size, Reason: "changed on the server since the last sync"}, nil
+	}
+
+	if l.size == r.size && opts.Hash {
+		same, err := c.SameContentCtx(ctx, filepath.Join(localDir, filepath.FromSlash(p)), path.Join(remoteDir, p))
+		if err != nil {
+			return nil, err
+		}
+		if same {
+			return nil, nil
+		}
+	}
+	return &SyncItem{Path: p, Action: SyncConflict, Size: l.size, Reason: "changed on both sides since the last sync"}, nil
+}
+
+// SameContentCtx compares a local and a remote file by SHA-256, downloading the remote file
+func (c *Client) SameContentCtx(ctx context.Context, localPath, remotePath string) (bool, error) {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	localHash := sha256.New()
+	if _, err := io.Copy(localHash, file); err != nil {
+		return false, err
+	}
+
+	remoteHash := sha256.New()
+	if _, err := c.DownloadFileCtx(ctx, remotePath, remoteHash); err != nil {
+		return false, err
+	}
+
+	return string(localHash.Sum(nil)) == string(remoteHash.Sum(nil)), nil
+}
+
+// executeSync runs the planned operations and records their errors in results
+func (c *Client) executeSync(ctx context.Context, localDir, remoteDir string, remote map[string]syncEntry, results []SyncResult, parallelism int) {
+	var uploads, downloads []fileTransferJob
+	var uploadIdx, downloadIdx []int
+	known := make(map[string]map[string]bool)
+
+	for i, res := range results {
+		localPath := filepath.Join(localDir, filepath.FromSlash(res.Path))
+		remotePath := path.Join(remoteDir, res.Path)
+		job := fileTransferJob{localPath: localPath, remotePath: remotePath, size: res.Size}
+
+		switch res.Action {
+		case SyncUpload:
+			if err := c.ensureRemoteDir(ctx, path.Dir(remotePath), known); err != nil {
+				results[i].Error = err.Error()
+				continue
+			}
+			uploads = append(uploads, job)
+			uploadIdx = append(uploadIdx, i)
+
+		case SyncDownload:
+			if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+				results[i].Error = err.Error()
+				continue
+			}
+			job.modifiedAt = remote[res.Path].modifiedAt
+			downloads = append(downloads, job)
+			downloadIdx = append(downloadIdx, i)
+
+		case SyncDeleteLocal:
+			if err := os.Remove(localPath); err != nil {
+				results[i].Error = err.Error()
+			}
+
+		case SyncDeleteRemote:
+			dir, name := path.Split(remotePath)
+			if err := c.DeleteFilesCtx(ctx, dir, []string{name}); err != nil {
+				results[i].Error = err.Error()
+			}
+		}
+	}
+
+	uploaded := runTransferJobs(ctx, uploads, parallelism, nil, func(ctx context.Context, job fileTransferJob) (int64, error) {
+		file, err := os.Open(job.localPath)
+		if err != nil {
+			return 0, err
+		}
+		defer file.Close()
+
+		dir, name := path.Split(job.remotePath)
+		return job.size, c.UploadStreamCtx(ctx, dir, name, file, job.size)
+	})
+	for j, res := range uploaded {
+		results[uploadIdx[j]].Error = res.Error
+	}
+
+	downloaded := runTransferJobs(ctx, downloads, parallelism, nil, func(ctx context.Context, job fileTransferJob) (int64, error) {
+		return c.downloadToPath(ctx, job.remotePath, job.localPath, job.modifiedAt)
+	})
+	for j, res := range downloaded {
+		results[downloadIdx[j]].Error = res.Error
+	}
+}
+
+// syncExcluded reports whether any element of a relative path matches an exclude pattern
+func syncExcluded(rel string, exclude []string) bool {
+	for _, element := range strings.Split(rel, "/") {
+		for _, pattern := range exclude {
+			if matched, _ := path.Match(pattern, element); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// listLocalTree returns all regular files below dir keyed by relative slash path
+func listLocalTree(dir string, exclude []string) (map[string]syncEntry, error) {
+	entries := make(map[string]syncEntry)
+
+	err := filepath.WalkDir(dir, func(localPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, localPath)
+		if err != nil || rel == "." {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if syncExcluded(rel, exclude) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			entries[rel] = syncEntry{size: info.Size(), modifiedAt: info.ModTime()}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local directory: %w", err)
+	}
+
+	return entries, nil
+}
+
+// listRemoteTree adds all files below remoteDir to entries keyed by relative slash path
+// A missing remote directory is treated as empty
+func (c *Client) listRemoteTree(ctx context.Context, remoteDir, prefix string, exclude []string, entries map[string]syncEntry) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	files, err := c.ListFilesCtx(ctx, path.Join(remoteDir, prefix))
+	if err != nil {
+		if prefix == "" {
+			if info, statErr := c.StatFileCtx(ctx, remoteDir); statErr == nil && info == nil {
+				return nil
+			}
+		}
+		return err
+	}
+
+	for _, f := range files {
+		rel := path.Join(prefix, f.Name)
+		if f.IsSymlink || syncExcluded(rel, exclude) {
+			continue
+		}
+
+		if !f.IsFile {
+			if err := c.listRemoteTree(ctx, remoteDir, rel, exclude, entries); err != nil {
+				return err
+			}
+			continue
+		}
+
+		entries[rel] = syncEntry{size: f.Size, modifiedAt: f.ModifiedAt}
+	}
+
+	return nil
+}
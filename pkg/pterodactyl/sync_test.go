@@ -0,0 +1,123 @@
+package pterodactyl
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPlanSyncMirror(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	later := base.Add(time.Hour)
+	synced := SyncStateFile{LocalSize: 10, LocalModifiedAt: base, RemoteSize: 10, RemoteModifiedAt: later}
+
+	tests := []struct {
+		name   string
+		local  map[string]syncEntry
+		remote map[string]syncEntry
+		state  *SyncState
+		delete bool
+		want   SyncAction // Empty for no operation
+	}{
+		{
+			name:   "first sync, newer locally",
+			local:  map[string]syncEntry{"a": {10, later}},
+			remote: map[string]syncEntry{"a": {10, base}},
+			want:   SyncUpload,
+		},
+		{
+			name:   "first sync, newer on the server",
+			local:  map[string]syncEntry{"a": {10, base}},
+			remote: map[string]syncEntry{"a": {10, later}},
+			want:   SyncDownload,
+		},
+		{
+			name:   "unchanged after a push left the server newer",
+			local:  map[string]syncEntry{"a": {10, base}},
+			remote: map[string]syncEntry{"a": {10, later}},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+		},
+		{
+			name:   "changed locally",
+			local:  map[string]syncEntry{"a": {12, base.Add(time.Minute)}},
+			remote: map[string]syncEntry{"a": {10, later}},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+			want:   SyncUpload,
+		},
+		{
+			name:   "changed on the server",
+			local:  map[string]syncEntry{"a": {10, base}},
+			remote: map[string]syncEntry{"a": {10, later.Add(time.Minute)}},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+			want:   SyncDownload,
+		},
+		{
+			name:   "changed on both sides",
+			local:  map[string]syncEntry{"a": {11, base.Add(time.Minute)}},
+			remote: map[string]syncEntry{"a": {12, later.Add(time.Minute)}},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+			want:   SyncConflict,
+		},
+		{
+			name:   "deleted on the server",
+			local:  map[string]syncEntry{"a": {10, base}},
+			remote: map[string]syncEntry{},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+			delete: true,
+			want:   SyncDeleteLocal,
+		},
+		{
+			name:   "deleted locally",
+			local:  map[string]syncEntry{},
+			remote: map[string]syncEntry{"a": {10, later}},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+			delete: true,
+			want:   SyncDeleteRemote,
+		},
+		{
+			name:   "deleted on the server without delete restores it",
+			local:  map[string]syncEntry{"a": {10, base}},
+			remote: map[string]syncEntry{},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+			want:   SyncUpload,
+		},
+		{
+			name:   "deleted on the server but changed locally",
+			local:  map[string]syncEntry{"a": {11, base.Add(time.Minute)}},
+			remote: map[string]syncEntry{},
+			state:  &SyncState{Files: map[string]SyncStateFile{"a": synced}},
+			delete: true,
+			want:   SyncUpload,
+		},
+		{
+			name:   "new locally without state is never deleted",
+			local:  map[string]syncEntry{"a": {10, base}},
+			remote: map[string]syncEntry{},
+			delete: true,
+			want:   SyncUpload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c *Client // Only used for hashing, which these cases don't enable
+			opts := SyncOptions{Mode: SyncMirror, Delete: tt.delete, State: tt.state}
+
+			items, err := c.planSync(context.Background(), "", "", tt.local, tt.remote, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got SyncAction
+			if len(items) > 1 {
+				t.Fatalf("planned %d operations, want at most 1", len(items))
+			}
+			if len(items) == 1 {
+				got = items[0].Action
+			}
+			if got != tt.want {
+				t.Errorf("planned %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,367 @@
+package pterodactyl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// OpenDownloadCtx starts streaming a file from the server
+// It returns the response body and the file size, or -1 if the size is unknown.
+// The caller must close the body; cancelling ctx aborts the transfer.
+func (c *Client) OpenDownloadCtx(ctx context.Context, path string) (io.ReadCloser, int64, error) {
+	downloadURL, err := c.GetDownloadURLCtx(ctx, path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create download request: %w", err)
+	}
+
+	resp, err := c.transfer.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, 0, parseAPIError(resp.StatusCode, body)
+	}
+
+	return resp.Body, resp.ContentLength, nil
+}
+
+// DownloadFile streams a file from the server into w and returns the number of bytes written
+func (c *Client) DownloadFile(path string, w io.Writer) (int64, error) {
+	return c.DownloadFileCtx(context.Background(), path, w)
+}
+
+// DownloadFileCtx streams a file from the server into w until done or ctx is cancelled
+func (c *Client) DownloadFileCtx(ctx context.Context, path string, w io.Writer) (int64, error) {
+	body, _, err := c.OpenDownloadCtx(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	written, err := io.Copy(w, body)
+	if err != nil {
+		return written, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	return written, nil
+}
+
+// UploadStreamCtx streams content into a file in the server directory path
+// size is the number of bytes content will yield, or -1 if unknown. With a known
+// size the request carries a Content-Length; otherwise it is sent chunked.
+// The content is never buffered in memory; cancelling ctx aborts the upload.
+func (c *Client) UploadStreamCtx(ctx context.Context, path, filename string, content io.Reader, size int64) error {
+	uploadURL, err := c.getUploadURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Render the multipart framing around the file up front so the file itself can be streamed
+	var framing bytes.Buffer
+	mw := multipart.NewWriter(&framing)
+	if _, err := mw.CreateFormFile("files", filename); err != nil {
+		return fmt.Errorf("failed to create upload form: %w", err)
+	}
+	head := append([]byte(nil), framing.Bytes()...)
+	framing.Reset()
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("failed to create upload form: %w", err)
+	}
+	tail := framing.Bytes()
+
+	body := io.MultiReader(bytes.NewReader(head), content, bytes.NewReader(tail))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL+"&directory="+url.QueryEscape(path), body)
+	if err != nil {
+		return fmt.Errorf("failed to create upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	if size >= 0 {
+		req.ContentLength = int64(len(head)) + size + int64(len(tail))
+	}
+
+	resp, err := c.transfer.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return parseAPIError(resp.StatusCode, respBody)
+	}
+
+	return nil
+}
+
+// defaultTransferParallelism is the number of concurrent file transfers used when none is given
+const defaultTransferParallelism = 4
+
+// FileTransferResult reports the outcome of a single file of a directory transfer
+type FileTransferResult struct {
+	LocalPath  string `json:"localPath"`
+	RemotePath string `json:"remotePath"`
+	Bytes      int64  `json:"bytes"`
+	Error      string `json:"error,omitempty"`
+}
+
+// fileTransferJob describes a single file of a directory transfer
+type fileTransferJob struct {
+	localPath  string
+	remotePath string
+	size       int64
+	modifiedAt time.Time
+}
+
+// runTransferJobs runs fn for every job using at most parallelism workers
+// Results are returned in job order; onFile is called as each file finishes.
+func runTransferJobs(ctx context.Context, jobs []fileTransferJob, parallelism int, onFile func(FileTransferResult), fn func(context.Context, fileTransferJob) (int64, error)) []FileTransferResult {
+	if parallelism <= 0 {
+		parallelism = defaultTransferParallelism
+	}
+
+	results := make([]FileTransferResult, len(jobs))
+	queue := make(chan int)
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex // Serializes onFile callbacks
+	)
+	for w := 0; w < parallelism; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				job := jobs[i]
+				result := FileTransferResult{LocalPath: job.localPath, RemotePath: job.remotePath}
+
+				if err := ctx.Err(); err != nil {
+					result.Error = err.Error()
+				} else if n, err := fn(ctx, job); err != nil {
+					result.Bytes = n
+					result.Error = err.Error()
+				} else {
+					result.Bytes = n
+				}
+
+				results[i] = result
+				if onFile != nil {
+					mu.Lock()
+					onFile(result)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := range jobs {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	return results
+}
+
+// UploadDirectoryCtx uploads a local directory tree into remoteDir
+// Missing remote directories are created and files are uploaded with at most
+// parallelism concurrent transfers. Per-file failures are reported in the results;
+// the error is only set when the transfer could not run at all.
+func (c *Client) UploadDirectoryCtx(ctx context.Context, localDir, remoteDir string, parallelism int, onFile func(FileTransferResult)) ([]FileTransferResult, error) {
+	var dirs []string
+	var jobs []fileTransferJob
+
+	err := filepath.WalkDir(localDir, func(localPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(localDir, localPath)
+		if err != nil {
+			return err
+		}
+		remotePath := path.Join(remoteDir, filepath.ToSlash(rel))
+
+		switch {
+		case d.IsDir():
+			dirs = append(dirs, remotePath)
+		case d.Type().IsRegular():
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			jobs = append(jobs, fileTransferJob{localPath: localPath, remotePath: remotePath, size: info.Size()})
+		}
+		// Symlinks and special files are skipped
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local directory: %w", err)
+	}
+
+	// WalkDir visits parents before their children, so creating in order is safe
+	known := make(map[string]map[string]bool)
+	for _, dir := range dirs {
+		if err := c.ensureRemoteDir(ctx, dir, known); err != nil {
+			return nil, err
+		}
+	}
+
+	results := runTransferJobs(ctx, jobs, parallelism, onFile, func(ctx context.Context, job fileTransferJob) (int64, error) {
+		file, err := os.Open(job.localPath)
+		if err != nil {
+			return 0, err
+		}
+		defer file.Close()
+
+		dir, name := path.Split(job.remotePath)
+		if err := c.UploadStreamCtx(ctx, dir, name, file, job.size); err != nil {
+			return 0, err
+		}
+		return job.size, nil
+	})
+
+	return results, nil
+}
+
+// ensureRemoteDir creates a remote directory and its missing parents
+// known caches the subdirectories of each listed remote directory
+func (c *Client) ensureRemoteDir(ctx context.Context, dir string, known map[string]map[string]bool) error {
+	dir = path.Clean("/" + dir)
+	if dir == "/" {
+		return nil
+	}
+
+	parent, name := path.Split(dir)
+	parent = path.Clean(parent)
+	if err := c.ensureRemoteDir(ctx, parent, known); err != nil {
+		return err
+	}
+
+	children, ok := known[parent]
+	if !ok {
+		files, err := c.ListFilesCtx(ctx, parent)
+		if err != nil {
+			return err
+		}
+		children = make(map[string]bool)
+		for _, f := range files {
+			if !f.IsFile && !f.IsSymlink {
+				children[f.Name] = true
+			}
+		}
+		known[parent] = children
+	}
+
+	if children[name] {
+		return nil
+	}
+
+	if err := c.CreateDirectoryCtx(ctx, parent, name); err != nil {
+		return err
+	}
+	children[name] = true
+	known[dir] = make(map[string]bool) // A new directory is empty
+
+	return nil
+}
+
+// DownloadDirectoryCtx downloads the remote directory tree remoteDir into localDir
+// Files are downloaded with at most parallelism concurrent transfers and keep their
+// remote modification time. Per-file failures are reported in the results; the error
+// is only set when the remote tree could not be listed.
+func (c *Client) DownloadDirectoryCtx(ctx context.Context, remoteDir, localDir string, parallelism int, onFile func(FileTransferResult)) ([]FileTransferResult, error) {
+	var jobs []fileTransferJob
+	if err := c.collectRemoteFiles(ctx, remoteDir, localDir, &jobs); err != nil {
+		return nil, err
+	}
+
+	results := runTransferJobs(ctx, jobs, parallelism, onFile, func(ctx context.Context, job fileTransferJob) (int64, error) {
+		return c.downloadToPath(ctx, job.remotePath, job.localPath, job.modifiedAt)
+	})
+
+	return results, nil
+}
+
+// collectRemoteFiles lists a remote tree recursively, creating the matching local directories
+func (c *Client) collectRemoteFiles(ctx context.Context, remoteDir, localDir string, jobs *[]fileTransferJob) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(localDir, 0755); err != nil {
+		return fmt.Errorf("failed to create local directory: %w", err)
+	}
+
+	files, err := c.ListFilesCtx(ctx, remoteDir)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		remotePath := path.Join(remoteDir, f.Name)
+		localPath := filepath.Join(localDir, f.Name)
+
+		switch {
+		case f.IsSymlink:
+			// Symlinks are skipped
+		case !f.IsFile:
+			if err := c.collectRemoteFiles(ctx, remotePath, localPath, jobs); err != nil {
+				return err
+			}
+		default:
+			*jobs = append(*jobs, fileTransferJob{localPath: localPath, remotePath: remotePath, size: f.Size, modifiedAt: f.ModifiedAt})
+		}
+	}
+
+	return nil
+}
+
+// downloadToPath downloads a file through a temporary file and sets its modification time
+func (c *Client) downloadToPath(ctx context.Context, remotePath, localPath string, modifiedAt time.Time) (int64, error) {
+	tmpPath := localPath + ".part"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, err
+	}
+
+	written, err := c.DownloadFileCtx(ctx, remotePath, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return written, err
+	}
+
+	if err := os.Rename(tmpPath, localPath); err != nil {
+		os.Remove(tmpPath)
+		return written, err
+	}
+
+	if !modifiedAt.IsZero() {
+		_ = os.Chtimes(localPath, modifiedAt, modifiedAt)
+	}
+
+	return written, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -18,6 +19,16 @@ func min(a, b int) int {
 	return b
 }
 
+// Delays between reconnection attempts, doubled after every failed attempt
+const (
+	reconnectInitialDelay = time.Second
+	reconnectMaxDelay     = time.Minute
+)
+
+// maxReconnectAttempts is how often a dropped connection is retried before giving up,
+// about ten minutes with the delays above
+const maxReconnectAttempts = 15
+
 // ConsoleWebSocket manages the WebSocket connection for console output
 type ConsoleWebSocket struct {
 	conn       *websocket.Conn
@@ -25,8 +36,45 @@ type ConsoleWebSocket struct {
 	token      string
 	serverID   string
 	panelOrigin string
+	mu         sync.Mutex // Guards conn, url, token and closed, and serializes writes
+	closed     bool
+	done       chan struct{}
 	OnOutput   func(string)
 	OnError    func(error)
+	OnBackupCompleted func(BackupCompletedEvent)
+	OnStats    func(Stats)
+	
+	// Refresh fetches new credentials when the token is expiring or the
+	// connection drops. Without it the connection is not re-established.
+	Refresh        func() (*WebSocketCredentials, error)
+	OnReconnecting func(attempt int, delay time.Duration, cause error)
+	OnReconnected  func()
+	// OnGaveUp is called after reconnecting failed for good and the WebSocket was closed
+	OnGaveUp func(cause error)
+}
+
+// BackupCompletedEvent represents the payload of a "backup completed" event
+type BackupCompletedEvent struct {
+	UUID         string `json:"uuid"`
+	IsSuccessful bool   `json:"is_successful"`
+	Checksum     string `json:"checksum"`
+	ChecksumType string `json:"checksum_type"`
+	FileSize     int64  `json:"file_size"`
+}
+
+// Stats represents a resource usage sample from the "stats" event
+type Stats struct {
+	Time             time.Time `json:"time"` // When the sample was received
+	State            string    `json:"state"`
+	CPUAbsolute      float64   `json:"cpu_absolute"`
+	MemoryBytes      int64     `json:"memory_bytes"`
+	MemoryLimitBytes int64     `json:"memory_limit_bytes"`
+	DiskBytes        int64     `json:"disk_bytes"`
+	Uptime           int64     `json:"uptime"` // Milliseconds
+	Network          struct {
+		RxBytes int64 `json:"rx_bytes"`
+		TxBytes int64 `json:"tx_bytes"`
+	} `json:"network"`
 }
 
 // NewConsoleWebSocket creates a new console WebSocket connection
@@ -38,6 +86,7 @@ func NewConsoleWebSocket(socket, token, serverID string) *ConsoleWebSocket {
 		token:    token,
 		serverID: serverID,
 		panelOrigin: "https://mc.bloom.host", // Default for Bloom Host
+		done:     make(chan struct{}),
 	}
 }
 
@@ -48,17 +97,49 @@ func NewConsoleWebSocketWithOrigin(socket, token, serverID, panelOrigin string)
 		token:       token,
 		serverID:    serverID,
 		panelOrigin: panelOrigin,
+		done:        make(chan struct{}),
 	}
 }
 
 // Connect establishes the WebSocket connection
 func (ws *ConsoleWebSocket) Connect() error {
+	conn, err := ws.dial()
+	if err != nil {
+		return err
+	}
+	
+	ws.mu.Lock()
+	ws.conn = conn
+	ws.mu.Unlock()
+	
+	// According to Pterodactyl docs, we need to send an auth event after connecting
+	// even though the token is in the URL
+	if err := ws.sendAuth(); err != nil {
+		conn.Close()
+		ws.mu.Lock()
+		ws.conn = nil
+		ws.mu.Unlock()
+		return fmt.Errorf("failed to send auth message: %w", err)
+	}
+	
+	// Start reading messages
+	go ws.readLoop()
+	
+	return nil
+}
+
+// dial opens a new connection using the current socket URL and token
+func (ws *ConsoleWebSocket) dial() (*websocket.Conn, error) {
+	ws.mu.Lock()
+	socketURL, token := ws.url, ws.token
+	ws.mu.Unlock()
+	
 	// Append the token to the WebSocket URL as a query parameter
 	separator := "?"
-	if strings.Contains(ws.url, "?") {
+	if strings.Contains(socketURL, "?") {
 		separator = "&"
 	}
-	connectURL := fmt.Sprintf("%s%stoken=%s", ws.url, separator, ws.token)
+	connectURL := fmt.Sprintf("%s%stoken=%s", socketURL, separator, token)
 	
 	// Set up headers
 	headers := http.Header{}
@@ -66,7 +147,7 @@ func (ws *ConsoleWebSocket) Connect() error {
 	origin := ws.panelOrigin
 	if origin == "" {
 		// Fallback: extract from WebSocket URL if no origin specified
-		origin = ws.url
+		origin = socketURL
 		origin = strings.Replace(origin, "wss://", "https://", 1)
 		origin = strings.Replace(origin, "ws://", "http://", 1)
 		if idx := strings.Index(origin, "/api/"); idx > 0 {
@@ -83,86 +164,105 @@ func (ws *ConsoleWebSocket) Connect() error {
 	conn, resp, err := dialer.Dial(connectURL, headers)
 	if err != nil {
 		if resp != nil {
-			return fmt.Errorf("failed to connect to websocket: %w (status: %d)", err, resp.StatusCode)
+			return nil, fmt.Errorf("failed to connect to websocket: %w (status: %d)", err, resp.StatusCode)
 		}
-		return fmt.Errorf("failed to connect to websocket: %w", err)
+		return nil, fmt.Errorf("failed to connect to websocket: %w", err)
 	}
 	
-	ws.conn = conn
-	
-	// Start reading messages
-	go ws.readLoop()
+	return conn, nil
+}
+
+// sendAuth sends the current token as an auth event
+func (ws *ConsoleWebSocket) sendAuth() error {
+	ws.mu.Lock()
+	token := ws.token
+	ws.mu.Unlock()
 	
-	// According to Pterodactyl docs, we need to send an auth event after connecting
-	// even though the token is in the URL
-	authMsg := map[string]interface{}{
+	return ws.writeJSON(map[string]interface{}{
 		"event": "auth",
-		"args":  []string{ws.token},
-	}
+		"args":  []string{token},
+	})
+}
+
+// writeJSON writes a message to the current connection
+func (ws *ConsoleWebSocket) writeJSON(msg interface{}) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
 	
-	if err := ws.conn.WriteJSON(authMsg); err != nil {
-		ws.conn.Close()
-		return fmt.Errorf("failed to send auth message: %w", err)
+	if ws.conn == nil {
+		return fmt.Errorf("not connected")
 	}
 	
-	// Connected, authentication sent
+	return ws.conn.WriteJSON(msg)
+}
+
+// setCredentials replaces the token and socket URL used for authentication
+func (ws *ConsoleWebSocket) setCredentials(creds *WebSocketCredentials) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
 	
-	return nil
+	ws.token = creds.Token
+	if creds.Socket != "" {
+		ws.url = creds.Socket
+	}
 }
 
 // RequestLogs requests the console logs
 func (ws *ConsoleWebSocket) RequestLogs() error {
-	if ws.conn == nil {
-		return fmt.Errorf("not connected")
-	}
-	
 	msg := map[string]interface{}{
 		"event": "send logs",
 		"args":  []interface{}{nil},
 	}
 	
-	return ws.conn.WriteJSON(msg)
+	return ws.writeJSON(msg)
 }
 
 // SendCommand sends a command to the console
 func (ws *ConsoleWebSocket) SendCommand(command string) error {
-	if ws.conn == nil {
-		return fmt.Errorf("not connected")
-	}
-	
 	msg := map[string]interface{}{
 		"event": "send command",
 		"args":  []string{command},
 	}
 	
-	return ws.conn.WriteJSON(msg)
+	return ws.writeJSON(msg)
 }
 
 // SendPowerState sends a power state change
 func (ws *ConsoleWebSocket) SendPowerState(state string) error {
-	if ws.conn == nil {
-		return fmt.Errorf("not connected")
-	}
-	
 	msg := map[string]interface{}{
 		"event": "set state",
 		"args":  []string{state},
 	}
 	
-	return ws.conn.WriteJSON(msg)
+	return ws.writeJSON(msg)
 }
 
 // readLoop reads messages from the WebSocket
 func (ws *ConsoleWebSocket) readLoop() {
-	defer ws.Close()
-	
 	for {
-		_, message, err := ws.conn.ReadMessage()
+		ws.mu.Lock()
+		conn := ws.conn
+		ws.mu.Unlock()
+		
+		_, message, err := conn.ReadMessage()
 		if err != nil {
-			if ws.OnError != nil && !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
-				ws.OnError(err)
+			if ws.isClosed() {
+				return
 			}
-			return
+			
+			if ws.Refresh == nil {
+				if ws.OnError != nil && !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					ws.OnError(err)
+				}
+				ws.Close()
+				return
+			}
+			
+			conn.Close()
+			if !ws.reconnect(err) {
+				return
+			}
+			continue
 		}
 		
 		// Debug: log event types (not raw messages)
@@ -191,8 +291,10 @@ func (ws *ConsoleWebSocket) readLoop() {
 			}
 			
 		case "stats":
-			// Server statistics update - silently handled
-			// Stats can be processed by a separate handler if needed
+			// Server statistics update, sent every few seconds while running
+			if ws.OnStats != nil {
+				ws.handleStats(msg)
+			}
 			
 		case "logs":
 			// Initial console logs
@@ -208,18 +310,24 @@ func (ws *ConsoleWebSocket) readLoop() {
 				}
 			}
 			
-		case "token expiring":
-			// Token is expiring, need to refresh
-			if ws.OnError != nil {
-				ws.OnError(fmt.Errorf("WebSocket token expiring, please reconnect"))
+		case "token expiring", "token expired":
+			// Without a way to refresh, the connection is lost once the token expires
+			if ws.Refresh == nil {
+				if event == "token expiring" {
+					if ws.OnError != nil {
+						ws.OnError(fmt.Errorf("WebSocket token expiring, please reconnect"))
+					}
+					continue
+				}
+				if ws.OnError != nil {
+					ws.OnError(fmt.Errorf("WebSocket token expired"))
+				}
+				ws.Close()
+				return
 			}
 			
-		case "token expired":
-			// Token expired, close connection
-			if ws.OnError != nil {
-				ws.OnError(fmt.Errorf("WebSocket token expired"))
-			}
-			return
+			// Fetching credentials is an API call, so don't block reading
+			go ws.refreshToken()
 			
 		case "auth success":
 			// Successfully authenticated - no message needed
@@ -231,22 +339,199 @@ func (ws *ConsoleWebSocket) readLoop() {
 					ws.OnOutput(fmt.Sprintf("[Server status: %s]", status))
 				}
 			}
+			
+		default:
+			// Backup events are published as "backup completed:<uuid>"
+			if strings.HasPrefix(event, "backup completed") && ws.OnBackupCompleted != nil {
+				ws.handleBackupCompleted(event, msg)
+			}
+		}
+	}
+}
+
+// handleBackupCompleted parses a backup completed event and passes it to OnBackupCompleted
+func (ws *ConsoleWebSocket) handleBackupCompleted(event string, msg map[string]interface{}) {
+	var backup BackupCompletedEvent
+	if args, ok := msg["args"].([]interface{}); ok && len(args) > 0 {
+		if payload, ok := args[0].(string); ok {
+			if err := json.Unmarshal([]byte(payload), &backup); err != nil {
+				return
+			}
+		}
+	}
+	
+	// Older daemons only include the UUID in the event name
+	if backup.UUID == "" {
+		backup.UUID = strings.TrimPrefix(strings.TrimPrefix(event, "backup completed"), ":")
+	}
+	
+	ws.OnBackupCompleted(backup)
+}
+
+// handleStats parses a stats event and passes it to OnStats
+func (ws *ConsoleWebSocket) handleStats(msg map[string]interface{}) {
+	args, ok := msg["args"].([]interface{})
+	if !ok || len(args) == 0 {
+		return
+	}
+	payload, ok := args[0].(string)
+	if !ok {
+		return
+	}
+	
+	var stats Stats
+	if err := json.Unmarshal([]byte(payload), &stats); err != nil {
+		return
+	}
+	stats.Time = time.Now()
+	
+	ws.OnStats(stats)
+}
+
+// refreshToken fetches new credentials and re-authenticates the current connection
+func (ws *ConsoleWebSocket) refreshToken() {
+	creds, err := ws.Refresh()
+	if err == nil {
+		ws.setCredentials(creds)
+		err = ws.sendAuth()
+	}
+	
+	if err != nil {
+		if ws.OnError != nil {
+			ws.OnError(fmt.Errorf("failed to refresh WebSocket token: %w", err))
+		}
+		
+		// Drop the connection so the read loop reconnects with new credentials
+		ws.mu.Lock()
+		if ws.conn != nil && !ws.closed {
+			ws.conn.Close()
 		}
+		ws.mu.Unlock()
 	}
 }
 
-// Close closes the WebSocket connection
+// reconnect re-establishes the connection with exponential backoff. It gives up
+// after maxReconnectAttempts, or at once when the panel rejects the credentials
+// request (4xx), e.g. for a deleted server or a revoked key; it then reports the
+// error, closes the WebSocket and returns false. It also returns false if the
+// WebSocket was closed in the meantime.
+func (ws *ConsoleWebSocket) reconnect(cause error) bool {
+	delay := reconnectInitialDelay
+	
+	for attempt := 1; ; attempt++ {
+		if ws.isClosed() {
+			return false
+		}
+		
+		if ws.OnReconnecting != nil {
+			ws.OnReconnecting(attempt, delay, cause)
+		}
+		
+		select {
+		case <-ws.done:
+			return false
+		case <-time.After(delay):
+		}
+		
+		if cause = ws.redial(); cause == nil {
+			if ws.OnReconnected != nil {
+				ws.OnReconnected()
+			}
+			return true
+		}
+		
+		if attempt >= maxReconnectAttempts || IsClientError(cause) {
+			ws.giveUp(cause)
+			return false
+		}
+		
+		delay *= 2
+		if delay > reconnectMaxDelay {
+			delay = reconnectMaxDelay
+		}
+	}
+}
+
+// giveUp reports that the connection can't be re-established and closes the WebSocket
+func (ws *ConsoleWebSocket) giveUp(cause error) {
+	if ws.isClosed() {
+		return
+	}
+	
+	if ws.OnError != nil {
+		ws.OnError(fmt.Errorf("console connection lost, stopped reconnecting: %w", cause))
+	}
+	ws.Close()
+	
+	if ws.OnGaveUp != nil {
+		ws.OnGaveUp(cause)
+	}
+}
+
+// redial fetches new credentials and replaces the current connection
+func (ws *ConsoleWebSocket) redial() error {
+	creds, err := ws.Refresh()
+	if err != nil {
+		return fmt.Errorf("failed to get WebSocket credentials: %w", err)
+	}
+	ws.setCredentials(creds)
+	
+	conn, err := ws.dial()
+	if err != nil {
+		return err
+	}
+	
+	ws.mu.Lock()
+	if ws.closed {
+		ws.mu.Unlock()
+		conn.Close()
+		return fmt.Errorf("websocket closed")
+	}
+	ws.conn = conn
+	ws.mu.Unlock()
+	
+	if err := ws.sendAuth(); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to send auth message: %w", err)
+	}
+	
+	return nil
+}
+
+// isClosed reports whether Close has been called
+func (ws *ConsoleWebSocket) isClosed() bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return ws.closed
+}
+
+// Close closes the WebSocket connection and stops reconnecting
 func (ws *ConsoleWebSocket) Close() error {
-	if ws.conn != nil {
+	ws.mu.Lock()
+	if ws.closed {
+		ws.mu.Unlock()
+		return nil
+	}
+	ws.closed = true
+	close(ws.done)
+	
+	conn := ws.conn
+	if conn != nil {
 		// Send close message
-		ws.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}
+	ws.mu.Unlock()
+	
+	if conn != nil {
 		time.Sleep(100 * time.Millisecond)
-		return ws.conn.Close()
+		return conn.Close()
 	}
 	return nil
 }
 
-// IsConnected returns true if the WebSocket is connected
+// IsConnected returns true if the WebSocket is connected or reconnecting
 func (ws *ConsoleWebSocket) IsConnected() bool {
-	return ws.conn != nil
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return ws.conn != nil && !ws.closed
 }
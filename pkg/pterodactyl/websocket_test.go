@@ -0,0 +1,47 @@
+package pterodactyl
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReconnectGivesUpOnRejectedCredentials(t *testing.T) {
+	ws := NewConsoleWebSocket("wss://panel.example.com/ws", "token", "abc123")
+
+	refreshes := 0
+	ws.Refresh = func() (*WebSocketCredentials, error) {
+		refreshes++
+		return nil, parseAPIError(403, []byte(`{"errors":[{"code":"AccessDeniedHttpException","status":"403","detail":"forbidden"}]}`))
+	}
+
+	var reported, gaveUp error
+	ws.OnError = func(err error) { reported = err }
+	ws.OnGaveUp = func(cause error) { gaveUp = cause }
+
+	done := make(chan bool)
+	go func() { done <- ws.reconnect(errors.New("connection dropped")) }()
+
+	select {
+	case ok := <-done:
+		if ok {
+			t.Fatal("reconnect() = true, want false")
+		}
+	case <-time.After(5 * time.Second):
+		ws.Close()
+		t.Fatal("reconnect() kept retrying after the credentials were rejected")
+	}
+
+	if refreshes != 1 {
+		t.Errorf("fetched credentials %d times, want 1", refreshes)
+	}
+	if !IsForbidden(reported) {
+		t.Errorf("OnError got %v, want the 403", reported)
+	}
+	if !IsForbidden(gaveUp) {
+		t.Errorf("OnGaveUp got %v, want the 403", gaveUp)
+	}
+	if !ws.isClosed() {
+		t.Error("WebSocket is still open after giving up")
+	}
+}